@@ -5,11 +5,15 @@ import (
 	"os"
 
 	"github.com/blackflagsoftware/tithe-declare/config"
+	bod "github.com/blackflagsoftware/tithe-declare/internal/entities/blackoutdate"
 	ema "github.com/blackflagsoftware/tithe-declare/internal/entities/emailreminder"
+	hh "github.com/blackflagsoftware/tithe-declare/internal/entities/household"
+	hm "github.com/blackflagsoftware/tithe-declare/internal/entities/householdmember"
 	log "github.com/blackflagsoftware/tithe-declare/internal/entities/login"
 	lr "github.com/blackflagsoftware/tithe-declare/internal/entities/loginrole"
 	rol "github.com/blackflagsoftware/tithe-declare/internal/entities/role"
 	td_ "github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	wl "github.com/blackflagsoftware/tithe-declare/internal/entities/waitlist"
 	l "github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
 	pb "github.com/blackflagsoftware/tithe-declare/pkg/proto"
 	mig "github.com/blackflagsoftware/tithe-declare/tools/migration/src"
@@ -40,14 +44,17 @@ func main() {
 	defer tcpListener.Close()
 	s := grpc.NewServer()
 
-	registerServices(s)
+	holdSweeper := registerServices(s)
+	holdSweeper.Start()
+	defer holdSweeper.Stop()
 
 	reflection.Register(s)
 	l.Default.Printf("Starting GRPC server on port: %s...\n", config.Srv.GrpcPort)
 	s.Serve(tcpListener)
 }
 
-func registerServices(s *grpc.Server) {
+// registerServices returns the hold sweeper for the TdDate domain, it is started by the caller
+func registerServices(s *grpc.Server) *td_.HoldSweeper {
 	// Role
 	drol := rol.InitializeRoleV1()
 	hrol := rol.NewRoleGrpc(*drol)
@@ -62,10 +69,26 @@ func registerServices(s *grpc.Server) {
 	pb.RegisterLoginRoleServiceServer(s, hlr)
 	// TdDate
 	dtd_ := td_.InitializeTdDateV1()
+	// EmailReminder, initialized before the TdDate handler copies the domain so it is registered for bookings
+	dema := ema.InitializeEmailReminderV1(dtd_)
+	// Household, also registered for bookings
+	dhh := hh.InitializeHouseholdV1(dtd_)
+	// Waitlist, registered for released slots
+	wl.InitializeWaitlistV1(dtd_)
+	holdSweeper := td_.InitializeHoldSweeperV1()
 	htd_ := td_.NewTdDateGrpc(*dtd_)
 	pb.RegisterTdDateServiceServer(s, htd_)
-	// EmailReminder
-	dema := ema.InitializeEmailReminderV1()
 	hema := ema.NewEmailReminderGrpc(*dema)
 	pb.RegisterEmailReminderServiceServer(s, hema)
+	hhh := hh.NewHouseholdGrpc(*dhh)
+	pb.RegisterHouseholdServiceServer(s, hhh)
+	// HouseholdMember
+	dhm := hm.InitializeHouseholdMemberV1()
+	hhm := hm.NewHouseholdMemberGrpc(*dhm)
+	pb.RegisterHouseholdMemberServiceServer(s, hhm)
+	// BlackoutDate
+	dbod := bod.InitializeBlackoutDateV1()
+	hbod := bod.NewBlackoutDateGrpc(*dbod)
+	pb.RegisterBlackoutDateServiceServer(s, hbod)
+	return holdSweeper
 }
@@ -18,13 +18,24 @@ import (
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/authclientcallback"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/authclientsecret"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/authrefresh"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/blackoutdate"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/emailcapture"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/emailreminder"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/household"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/householdmember"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/interviewer"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/login"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/loginreset"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/loginrole"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/notification"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/registerroute"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/reminderrule"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/role"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/schedulerjob"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/scheduletemplate"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/season"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/waitlist"
 	mid "github.com/blackflagsoftware/tithe-declare/internal/middleware"
 	l "github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
 	rt "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
@@ -80,6 +91,7 @@ func main() {
 
 	InitializeRoutes()
 	RegisterRoutes(e)
+	tddate.RegisterTdDateFeed(e, middleware.BasicAuthWithConfig(mid.StrictBasicAuthConfig()))
 
 	go func() {
 		// if err := e.StartTLS(fmt.Sprintf(":%s", restPort), "", ""); err != nil && err != http.ErrServerClosed { // or TLS, supplying the cert/key files as needed
@@ -88,42 +100,17 @@ func main() {
 		}
 	}()
 
-	ctx, cancelCheck := context.WithCancel(context.Background())
-	go func(ctx context.Context) {
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-time.After(1 * time.Minute):
-				sql := tddate.InitSQLV1()
-				mgr := tddate.NewDomainTdDateV1(sql)
-				mgr.CheckHoldConfirm(ctx)
-			}
-		}
-	}(ctx)
-
-	ctx, cancelEmail := context.WithCancel(context.Background())
-	go func(ctx context.Context) {
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			case <-time.After(1 * time.Minute):
-				sql := emailreminder.InitSQLV1()
-				mgr := emailreminder.NewDomainEmailReminderV1(sql)
-				if err := mgr.SendEmail(ctx); err != nil {
-					l.Default.Printf("error sending email reminders: %s", err)
-				}
-			}
-		}
-	}(ctx)
+	holdSweeper := tddate.InitializeHoldSweeperV1()
+	holdSweeper.Start()
+	scheduler := schedulerjob.InitializeSchedulerV1()
+	scheduler.Start()
 
 	// main server wait to exit
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt)
 	<-quit
-	cancelCheck()
-	cancelEmail()
+	holdSweeper.Stop()
+	scheduler.Stop()
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 	if err := e.Shutdown(ctx); err != nil {
@@ -167,8 +154,33 @@ func InitializeRoutes() {
 	authauthorize.InitializeAuthAuthorizeV1()
 	authclient.InitializeAuthClientV1()
 	auth.InitializeAuthV1()
-	tddate.InitializeTdDateV1()
-	emailreminder.InitializeEmailReminderV1()
+	tdDomain := tddate.InitializeTdDateV1()
+	emailDomain := emailreminder.InitializeEmailReminderV1(tdDomain)
+	scheduletemplate.InitializeScheduleTemplateV1()
+	interviewer.InitializeInterviewerV1()
+	waitlist.InitializeWaitlistV1(tdDomain)
+	notificationDomain := notification.InitializeNotificationV1()
+	reminderrule.InitializeReminderRuleV1()
+	emailcapture.InitializeEmailCaptureV1()
+	household.InitializeHouseholdV1(tdDomain)
+	householdmember.InitializeHouseholdMemberV1()
+	season.InitializeSeasonV1()
+	blackoutdate.InitializeBlackoutDateV1()
+	jobDomain := schedulerjob.InitializeSchedulerJobV1()
+	registerJobs(jobDomain, emailDomain, notificationDomain)
+}
+
+// registerJobs adds the jobs run by the scheduler, see the scheduler-job endpoints to check on or run them
+func registerJobs(jobDomain *schedulerjob.DomainSchedulerJobV1, emailDomain *emailreminder.DomainEmailReminderV1, notificationDomain *notification.DomainNotificationV1) {
+	jobs := []schedulerjob.Job{
+		{Name: "email-reminder", Cron: config.Sch.ReminderCron, Run: emailDomain.SendEmail},
+		{Name: "notification-delivery", Cron: "* * * * *", Run: notificationDomain.Deliver},
+	}
+	for _, job := range jobs {
+		if err := jobDomain.Register(context.Background(), job); err != nil {
+			l.Default.Panicf("Unable to register scheduler job: %s, due to: %s", job.Name, err)
+		}
+	}
 }
 
 func RegisterRoutes(e *echo.Echo) {
@@ -190,6 +202,17 @@ func RegisterRoutes(e *echo.Echo) {
 	auth.RegisterAuth(routeGroup)
 	tddate.RegisterTdDate(routeGroup)
 	emailreminder.RegisterEmailReminder(routeGroup)
+	scheduletemplate.RegisterScheduleTemplate(routeGroup)
+	interviewer.RegisterInterviewer(routeGroup)
+	waitlist.RegisterWaitlist(routeGroup)
+	schedulerjob.RegisterSchedulerJob(routeGroup)
+	notification.RegisterNotification(routeGroup)
+	reminderrule.RegisterReminderRule(routeGroup)
+	emailcapture.RegisterEmailCapture(routeGroup)
+	household.RegisterHousehold(routeGroup)
+	householdmember.RegisterHouseholdMember(routeGroup)
+	season.RegisterSeason(routeGroup)
+	blackoutdate.RegisterBlackoutDate(routeGroup)
 }
 
 func additionalMiddlewareSetup(rg *echo.Group) {
@@ -6,6 +6,9 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"sync"
+	"time"
+	_ "time/tzdata" // the container may not have a zoneinfo database
 
 	"github.com/kardianos/osext"
 )
@@ -50,13 +53,44 @@ type (
 	}
 
 	Email struct {
-		Host       string
-		Port       string
-		Pwd        string
-		From       string
-		ResetUrl   string
-		AdminEmail string
+		Host        string
+		Port        string
+		Pwd         string
+		From        string
+		ResetUrl    string
+		ManageUrl   string
+		ClaimUrl    string
+		BookUrl     string
+		AdminEmail  string
+		TemplateDir string
+		Transport   string
+		Security    string
+		Auth        string
+		User        string
+		Dir         string
 	}
+
+	SMS struct {
+		Provider       string
+		WebhookUrl     string
+		WebhookToken   string
+		From           string
+		DefaultCountry string
+	}
+
+	Scheduling struct {
+		SlotDuration     string
+		SlotBuffer       string
+		ManageSecret     string
+		HoldTTL          string
+		WaitlistClaimTTL string
+		Location         string
+		Timezone         string
+		ReminderCron     string
+		NoShowLimit      string
+		NoShowWindow     string
+	}
+
 	Auth struct {
 		PwdCost              string
 		ResetDuration        string
@@ -85,6 +119,15 @@ var (
 	DB  Database
 	E   Email
 	A   Auth
+	Sch Scheduling
+	S   SMS
+
+	// see Scheduling.GetTimezone
+	timezone struct {
+		sync.Mutex
+		name string
+		loc  *time.Location
+	}
 )
 
 func init() {
@@ -96,6 +139,8 @@ func init() {
 	Mig = Migration{}
 	Aud = Auditing{}
 	BA = BasicAuth{}
+	Sch = Scheduling{}
+	S = SMS{}
 	loadEnvFiles()
 	loadEnvVars()
 }
@@ -120,12 +165,37 @@ func loadEnvVars() {
 	// BA.BasicAuthPwd = GetEnvOrDefault("TITHE_DECLARE_BASIC_AUTH_PWD", "test")
 	DB.Engine = GetEnvOrDefault("TITHE_DECLARE_SQLITE_DB_ENGINE", "sqlite")
 	DB.SqlitePath = GetEnvOrDefault("TITHE_DECLARE_SQLITE_PATH", "")
+	Sch.SlotDuration = GetEnvOrDefault("TITHE_DECLARE_SLOT_DURATION", "15")          // in minutes
+	Sch.SlotBuffer = GetEnvOrDefault("TITHE_DECLARE_SLOT_BUFFER", "0")               // in minutes, gap left between slots
+	Sch.ManageSecret = GetEnvOrDefault("TITHE_DECLARE_MANAGE_SECRET", "")            // signs the cancel/reschedule links, falls back to the auth secret
+	Sch.HoldTTL = GetEnvOrDefault("TITHE_DECLARE_HOLD_TTL", "10")                    // in minutes, how long a time is held before it is released
+	Sch.WaitlistClaimTTL = GetEnvOrDefault("TITHE_DECLARE_WAITLIST_CLAIM_TTL", "60") // in minutes, how long a time offered to the waitlist is held
+	Sch.Location = GetEnvOrDefault("TITHE_DECLARE_LOCATION", "")                     // where declarations are held, shown on calendar events
+	Sch.Timezone = GetEnvOrDefault("TITHE_DECLARE_TIMEZONE", "UTC")                  // IANA name (e.g.: America/Denver) times are entered and shown in, stored as UTC
+	Sch.ReminderCron = GetEnvOrDefault("TITHE_DECLARE_REMINDER_CRON", "*/5 * * * *") // how often the reminder rules are checked, see the reminder-rule endpoints
+	Sch.NoShowLimit = GetEnvOrDefault("TITHE_DECLARE_NO_SHOW_LIMIT", "1")            // no-show follow-ups sent to the same address within the window, 0 => none are sent
+	Sch.NoShowWindow = GetEnvOrDefault("TITHE_DECLARE_NO_SHOW_WINDOW", "30")         // in days
+	Sch.GetTimezone()                                                                // loaded (or warned about) once at start up
 	E.Host = GetEnvOrDefault("TITHE_DECLARE_EMAIL_HOST", "")
 	E.Port = GetEnvOrDefault("TITHE_DECLARE_EMAIL_PORT", "587")
 	E.Pwd = GetEnvOrDefault("TITHE_DECLARE_EMAIL_PWD", "")
+	E.User = GetEnvOrDefault("TITHE_DECLARE_EMAIL_USER", "")                 // smtp login, falls back to the from address
+	E.Transport = GetEnvOrDefault("TITHE_DECLARE_EMAIL_TRANSPORT", "")       // smtp, file, maildir or memory; defaults to smtp when there is a host otherwise memory
+	E.Security = GetEnvOrDefault("TITHE_DECLARE_EMAIL_SECURITY", "starttls") // smtp: starttls (when the server offers it), require (starttls or fail), tls (implicit, e.g.: port 465) or none
+	E.Auth = GetEnvOrDefault("TITHE_DECLARE_EMAIL_AUTH", "plain")            // smtp: plain, login, cram-md5 or none
+	E.Dir = GetEnvOrDefault("TITHE_DECLARE_EMAIL_DIR", "./mail")             // file/maildir: where the messages are written
 	E.From = GetEnvOrDefault("TITHE_DECLARE_EMAIL_FROM", "")
 	E.ResetUrl = GetEnvOrDefault("TITHE_DECLARE_EMAIL_RESET_URL", "")
+	E.ManageUrl = GetEnvOrDefault("TITHE_DECLARE_EMAIL_MANAGE_URL", "")
+	E.ClaimUrl = GetEnvOrDefault("TITHE_DECLARE_EMAIL_CLAIM_URL", "")
+	E.BookUrl = GetEnvOrDefault("TITHE_DECLARE_EMAIL_BOOK_URL", "") // where families book a time, linked from the no-show follow-up
 	E.AdminEmail = GetEnvOrDefault("TITHE_DECLARE_ADMIN_EMAIL", "")
+	E.TemplateDir = GetEnvOrDefault("TITHE_DECLARE_EMAIL_TEMPLATE_DIR", "") // a file here (e.g.: reset.html) is used instead of the built in one, see internal/util/email/templates
+	S.Provider = GetEnvOrDefault("TITHE_DECLARE_SMS_PROVIDER", "log")       // webhook or log (only logs the message)
+	S.WebhookUrl = GetEnvOrDefault("TITHE_DECLARE_SMS_WEBHOOK_URL", "")     // webhook: posted {"from", "to", "body"} as json
+	S.WebhookToken = GetEnvOrDefault("TITHE_DECLARE_SMS_WEBHOOK_TOKEN", "") // webhook: sent as a Bearer token
+	S.From = GetEnvOrDefault("TITHE_DECLARE_SMS_FROM", "")
+	S.DefaultCountry = GetEnvOrDefault("TITHE_DECLARE_SMS_DEFAULT_COUNTRY", "1")      // calling code given to numbers entered without one
 	A.PwdCost = GetEnvOrDefault("TITHE_DECLARE_PWD_COST", "10")                       // algorithm cost
 	A.ResetDuration = GetEnvOrDefault("TITHE_DECLARE_RESET_DURATION", "7")            // in days
 	A.ExpiresAtDuration = GetEnvOrDefault("TITHE_DECLARE_EXPIRES_AT_DURATION", "168") // in hours (7 days)
@@ -216,6 +286,64 @@ func (e Email) GetEmailPort() int {
 	return emailPort
 }
 
+func (s Scheduling) GetSlotDuration() int {
+	duration := ConvertEnvVarStringToInt(s.SlotDuration, "SlotDuration", 15)
+	return duration
+}
+
+func (s Scheduling) GetSlotBuffer() int {
+	buffer := ConvertEnvVarStringToInt(s.SlotBuffer, "SlotBuffer", 0)
+	return buffer
+}
+
+func (s Scheduling) GetHoldTTL() int {
+	ttl := ConvertEnvVarStringToInt(s.HoldTTL, "HoldTTL", 10)
+	return ttl
+}
+
+func (s Scheduling) GetWaitlistClaimTTL() int {
+	ttl := ConvertEnvVarStringToInt(s.WaitlistClaimTTL, "WaitlistClaimTTL", 60)
+	return ttl
+}
+
+func (s Scheduling) GetNoShowLimit() int {
+	limit := ConvertEnvVarStringToInt(s.NoShowLimit, "NoShowLimit", 1)
+	return limit
+}
+
+func (s Scheduling) GetNoShowWindow() int {
+	window := ConvertEnvVarStringToInt(s.NoShowWindow, "NoShowWindow", 30)
+	return window
+}
+
+// GetTimezone is the unit's timezone, an unknown name falls back to UTC
+// the location is loaded (and an unknown name warned about) once per name, not on every call
+func (s Scheduling) GetTimezone() *time.Location {
+	timezone.Lock()
+	defer timezone.Unlock()
+	if timezone.loc == nil || timezone.name != s.Timezone {
+		timezone.name = s.Timezone
+		timezone.loc = loadTimezone(s.Timezone)
+	}
+	return timezone.loc
+}
+
+func loadTimezone(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		fmt.Printf("Timezone: %s is invalid, defaulting to UTC\n", name)
+		return time.UTC
+	}
+	return loc
+}
+
+func (s Scheduling) GetManageSecret() string {
+	if s.ManageSecret != "" {
+		return s.ManageSecret
+	}
+	return A.AuthSecret
+}
+
 func (a Auth) GetPwdCost() int {
 	cost := ConvertEnvVarStringToInt(a.PwdCost, "PwdCost", 10)
 	return cost
@@ -14,18 +14,26 @@ import (
 
 type (
 	ApiError struct {
-		ApiErrorCode string `json:"api_error_code"`
-		StatusCode   int    `json:"status_code"`
-		Title        string `json:"title"`
-		Detail       string `json:"detail"`
-		Severe       bool   `json:"severe"`
+		ApiErrorCode string       `json:"api_error_code"`
+		StatusCode   int          `json:"status_code"`
+		Title        string       `json:"title"`
+		Detail       string       `json:"detail"`
+		Severe       bool         `json:"severe"`
+		Fields       []FieldError `json:"fields,omitempty"` // per input, see ValidationError
 		ProgramData  `json:"program_data"`
 	}
 
+	// FieldError is what is wrong with a single input, field is the json name the front end sent
+	FieldError struct {
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+
 	BodyError struct {
-		StatusCode int    `json:"status_code"`
-		Title      string `json:"title"`
-		Detail     string `json:"detail"`
+		StatusCode int          `json:"status_code"`
+		Title      string       `json:"title"`
+		Detail     string       `json:"detail"`
+		Fields     []FieldError `json:"fields,omitempty"`
 	}
 
 	ProgramData struct {
@@ -99,7 +107,7 @@ func (e ApiError) Error() string {
 }
 
 func (e ApiError) BodyError() BodyError {
-	return BodyError{StatusCode: e.StatusCode, Title: e.Title, Detail: e.Detail}
+	return BodyError{StatusCode: e.StatusCode, Title: e.Title, Detail: e.Detail, Fields: e.Fields}
 }
 
 func SetCaller(err error, stackLevel int) ProgramData {
@@ -242,6 +250,22 @@ func ParamError(name string, err error) ApiError {
 	)
 }
 
+func ValidationError(fields []FieldError) ApiError {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = f.Field
+	}
+	apiErr := NewApiError(
+		http.StatusBadRequest,
+		"Validation Error",
+		fmt.Sprintf("Invalid field(s): %s", strings.Join(names, ", ")),
+		false,
+		nil,
+	)
+	apiErr.Fields = fields
+	return apiErr
+}
+
 func AuthorizationError(detail string) ApiError {
 	return NewApiError(
 		http.StatusUnauthorized,
@@ -312,6 +336,66 @@ func ResetTokenInvalidError() ApiError {
 	)
 }
 
+func HoldTokenInvalidError() ApiError {
+	return NewApiError(
+		http.StatusConflict,
+		"Hold Not Found",
+		"Your hold has expired or is not valid, please choose the time again",
+		false,
+		nil,
+	)
+}
+
+func ManageTokenInvalidError() ApiError {
+	return NewApiError(
+		http.StatusNotFound,
+		"Invalid Manage Token",
+		"Link is invalid or the declaration has been cancelled",
+		false,
+		nil,
+	)
+}
+
+func BookingLockedError() ApiError {
+	return NewApiError(
+		http.StatusConflict,
+		"Declaration Locked",
+		"The declaration has already taken place and can no longer be changed, please contact the clerk",
+		false,
+		nil,
+	)
+}
+
+func PastTimeError() ApiError {
+	return NewApiError(
+		http.StatusBadRequest,
+		"Time Has Passed",
+		"Please choose a time in the future",
+		false,
+		nil,
+	)
+}
+
+func JobNotFoundError(name string) ApiError {
+	return NewApiError(
+		http.StatusNotFound,
+		"Job Not Found",
+		fmt.Sprintf("No scheduler job named: %s", name),
+		false,
+		nil,
+	)
+}
+
+func JobRunningError(name string) ApiError {
+	return NewApiError(
+		http.StatusConflict,
+		"Job Running",
+		fmt.Sprintf("Scheduler job: %s is already running", name),
+		false,
+		nil,
+	)
+}
+
 func LoginActiveError() ApiError {
 	return NewApiError(
 		http.StatusBadRequest,
@@ -351,3 +435,43 @@ func HoldError() ApiError {
 		nil,
 	)
 }
+
+func SeasonArchivedError(name string) ApiError {
+	return NewApiError(
+		http.StatusConflict,
+		"Season Archived",
+		fmt.Sprintf("Season: %s is archived and can no longer be changed", name),
+		false,
+		nil,
+	)
+}
+
+func SeasonClosedError() ApiError {
+	return NewApiError(
+		http.StatusLocked,
+		"Season Not Open",
+		"That time is not in the season currently open for booking",
+		false,
+		nil,
+	)
+}
+
+func BlackoutDateError(name string) ApiError {
+	return NewApiError(
+		http.StatusConflict,
+		"Blackout Date",
+		fmt.Sprintf("That day is closed for: %s", name),
+		false,
+		nil,
+	)
+}
+
+func SeasonOverlapError(name string) ApiError {
+	return NewApiError(
+		http.StatusConflict,
+		"Season Overlap",
+		fmt.Sprintf("The dates overlap with season: %s", name),
+		false,
+		nil,
+	)
+}
@@ -0,0 +1,130 @@
+package blackoutdate
+
+import (
+	"context"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=blackoutdate
+type (
+	DataBlackoutDateV1Adapter interface {
+		Read(context.Context, *BlackoutDate) error
+		ReadAll(context.Context, *[]BlackoutDate, BlackoutDateParam) (int, error)
+		Create(context.Context, *BlackoutDate) error
+		Update(context.Context, BlackoutDate) error
+		Delete(context.Context, *BlackoutDate) error
+		List(context.Context, *[]BlackoutDate) error
+	}
+
+	DomainBlackoutDateV1 struct {
+		dataBlackoutDateV1 DataBlackoutDateV1Adapter
+		auditWriter        a.AuditAdapter
+	}
+)
+
+func NewDomainBlackoutDateV1(cbodV1 DataBlackoutDateV1Adapter) *DomainBlackoutDateV1 {
+	aw := a.AuditInit()
+	return &DomainBlackoutDateV1{dataBlackoutDateV1: cbodV1, auditWriter: aw}
+}
+
+func (m *DomainBlackoutDateV1) Get(ctx context.Context, bod *BlackoutDate) error {
+	if bod.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	return m.dataBlackoutDateV1.Read(ctx, bod)
+}
+
+func (m *DomainBlackoutDateV1) Search(ctx context.Context, bod *[]BlackoutDate, param BlackoutDateParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("start_date", map[string]string{"id": "id", "name": "name", "start_date": "start_date", "end_date": "end_date"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataBlackoutDateV1.ReadAll(ctx, bod, param)
+}
+
+// Post adds the blackout, slots already on file for those days are kept but no longer offered
+func (m *DomainBlackoutDateV1) Post(ctx context.Context, bod *BlackoutDate) error {
+	if !bod.Name.Valid {
+		return ae.MissingParamError("Name")
+	}
+	if !bod.StartDate.Valid {
+		return ae.MissingParamError("StartDate")
+	}
+	if bod.EndDate.ValueOrZero() == "" {
+		bod.EndDate = bod.StartDate
+	}
+	if err := bod.validate(); err != nil {
+		return err
+	}
+	if err := m.dataBlackoutDateV1.Create(ctx, bod); err != nil {
+		return err
+	}
+	go a.AuditCreate(m.auditWriter, *bod, BlackoutDateConst, a.KeysToString("id", bod.Id))
+	return nil
+}
+
+func (m *DomainBlackoutDateV1) Patch(ctx context.Context, bodIn BlackoutDate) error {
+	bod := &BlackoutDate{Id: bodIn.Id}
+	errGet := m.dataBlackoutDateV1.Read(ctx, bod)
+	if errGet != nil {
+		return errGet
+	}
+	existingValues := make(map[string]any)
+	// Name
+	if bodIn.Name.Valid {
+		existingValues["name"] = bod.Name.String
+		bod.Name = bodIn.Name
+	}
+	// StartDate
+	if bodIn.StartDate.Valid {
+		existingValues["start_date"] = bod.StartDate.String
+		bod.StartDate = bodIn.StartDate
+	}
+	// EndDate
+	if bodIn.EndDate.Valid {
+		existingValues["end_date"] = bod.EndDate.String
+		bod.EndDate = bodIn.EndDate
+	}
+	if err := bod.validate(); err != nil {
+		return err
+	}
+	if err := m.dataBlackoutDateV1.Update(ctx, *bod); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *bod, BlackoutDateConst, a.KeysToString("id", bod.Id), existingValues)
+	return nil
+}
+
+func (m *DomainBlackoutDateV1) Delete(ctx context.Context, bod *BlackoutDate) error {
+	if bod.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataBlackoutDateV1.Delete(ctx, bod); err != nil {
+		return err
+	}
+	go a.AuditDelete(m.auditWriter, *bod, BlackoutDateConst, a.KeysToString("id", bod.Id))
+	return nil
+}
+
+func (b BlackoutDate) validate() error {
+	if len(b.Name.ValueOrZero()) > 100 {
+		return ae.StringLengthError("Name", 100)
+	}
+	startDate, errStart := time.Parse(layoutDate, b.StartDate.String)
+	if errStart != nil {
+		return ae.ParseError("StartDate not in correct format")
+	}
+	endDate, errEnd := time.Parse(layoutDate, b.EndDate.String)
+	if errEnd != nil {
+		return ae.ParseError("EndDate not in correct format")
+	}
+	if endDate.Before(startDate) {
+		return ae.ParseError("EndDate must not be before StartDate")
+	}
+	return nil
+}
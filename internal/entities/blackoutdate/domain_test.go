@@ -0,0 +1,65 @@
+package blackoutdate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestBlackoutDate_Contains(t *testing.T) {
+	tz, _ := time.LoadLocation("America/Denver")
+	christmas := BlackoutDate{StartDate: null.StringFrom("2025-12-24"), EndDate: null.StringFrom("2025-12-25")}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want bool
+	}{
+		{"day before", time.Date(2025, 12, 23, 23, 59, 0, 0, tz), false},
+		{"first day", time.Date(2025, 12, 24, 0, 0, 0, 0, tz), true},
+		{"last evening", time.Date(2025, 12, 25, 21, 0, 0, 0, tz), true},
+		{"day after", time.Date(2025, 12, 26, 0, 0, 0, 0, tz), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, christmas.Contains(tt.now.UTC(), tz), "BlackoutDate.Contains().%s", tt.name)
+		})
+	}
+	_, found := For([]BlackoutDate{christmas}, time.Date(2025, 12, 25, 9, 0, 0, 0, tz).UTC(), tz)
+	assert.True(t, found)
+}
+
+func TestDomainBlackoutDateV1_Post(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataBlackoutDate := NewMockDataBlackoutDateV1Adapter(ctrl)
+	mockDataBlackoutDate.EXPECT().Create(ctx, gomock.Any()).Return(nil).AnyTimes()
+
+	tests := []struct {
+		name    string
+		bod     *BlackoutDate
+		wantEnd string
+		wantErr bool
+	}{
+		{"successful", &BlackoutDate{Name: null.StringFrom("Christmas"), StartDate: null.StringFrom("2025-12-24"), EndDate: null.StringFrom("2025-12-25")}, "2025-12-25", false},
+		{"successful - single day", &BlackoutDate{Name: null.StringFrom("Stake Conference"), StartDate: null.StringFrom("2025-11-09")}, "2025-11-09", false},
+		{"failed - missing name", &BlackoutDate{StartDate: null.StringFrom("2025-11-09")}, "", true},
+		{"failed - missing start", &BlackoutDate{Name: null.StringFrom("Stake Conference")}, "", true},
+		{"failed - date format", &BlackoutDate{Name: null.StringFrom("Stake Conference"), StartDate: null.StringFrom("11/09/2025")}, "", true},
+		{"failed - end before start", &BlackoutDate{Name: null.StringFrom("Christmas"), StartDate: null.StringFrom("2025-12-25"), EndDate: null.StringFrom("2025-12-24")}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DomainBlackoutDateV1{dataBlackoutDateV1: mockDataBlackoutDate}
+			err := m.Post(ctx, tt.bod)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainBlackoutDateV1.Post().%s => expected error: got: %s", tt.name, err)
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantEnd, tt.bod.EndDate.String)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package blackoutdate
+
+import (
+	"context"
+	"encoding/json"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	p "github.com/blackflagsoftware/tithe-declare/pkg/proto"
+)
+
+type (
+	BlackoutDateGrpc struct {
+		p.UnimplementedBlackoutDateServiceServer
+		domainBlackoutDate DomainBlackoutDateV1
+	}
+)
+
+func NewBlackoutDateGrpc(mbod DomainBlackoutDateV1) *BlackoutDateGrpc {
+	return &BlackoutDateGrpc{domainBlackoutDate: mbod}
+}
+
+func (a *BlackoutDateGrpc) GetBlackoutDate(ctx context.Context, in *p.BlackoutDateIDIn) (*p.BlackoutDateResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.BlackoutDateResponse{Result: result}
+	bod := &BlackoutDate{Id: int(in.Id)}
+	if err := a.domainBlackoutDate.Get(ctx, bod); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	var err error
+	response.BlackoutDate, err = translateOut(bod)
+	if err != nil {
+		return response, err
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *BlackoutDateGrpc) SearchBlackoutDate(ctx context.Context, in *p.BlackoutDate) (*p.BlackoutDateRepeatResponse, error) {
+	blackoutDateParam := BlackoutDateParam{}
+	result := &p.Result{Success: false}
+	response := &p.BlackoutDateRepeatResponse{Result: result}
+	bods := &[]BlackoutDate{}
+	if _, err := a.domainBlackoutDate.Search(ctx, bods, blackoutDateParam); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	for _, a := range *bods {
+		protoBlackoutDate, err := translateOut(&a)
+		if err != nil {
+			return response, err
+		}
+		response.BlackoutDate = append(response.BlackoutDate, protoBlackoutDate)
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *BlackoutDateGrpc) CreateBlackoutDate(ctx context.Context, in *p.BlackoutDate) (*p.BlackoutDateResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.BlackoutDateResponse{Result: result}
+	bod, err := translateIn(in)
+	if err != nil {
+		return response, err
+	}
+	if err := a.domainBlackoutDate.Post(ctx, bod); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	var errTranslate error
+	response.BlackoutDate, errTranslate = translateOut(bod)
+	if errTranslate != nil {
+		return response, errTranslate
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *BlackoutDateGrpc) UpdateBlackoutDate(ctx context.Context, in *p.BlackoutDate) (*p.Result, error) {
+	response := &p.Result{Success: false}
+	bod, err := translateIn(in)
+	if err != nil {
+		return response, err
+	}
+	if err := a.domainBlackoutDate.Patch(ctx, *bod); err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+	response.Success = true
+	return response, nil
+}
+
+func (a *BlackoutDateGrpc) DeleteBlackoutDate(ctx context.Context, in *p.BlackoutDateIDIn) (*p.Result, error) {
+	response := &p.Result{Success: false}
+	bod := &BlackoutDate{Id: int(in.Id)}
+	if err := a.domainBlackoutDate.Delete(ctx, bod); err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+	response.Success = true
+	return response, nil
+}
+
+func translateOut(bod *BlackoutDate) (*p.BlackoutDate, error) {
+	protoBlackoutDate := p.BlackoutDate{}
+	protoBlackoutDate.Id = int64(bod.Id)
+	protoBlackoutDate.Name = bod.Name.String
+	protoBlackoutDate.StartDate = bod.StartDate.String
+	protoBlackoutDate.EndDate = bod.EndDate.String
+	return &protoBlackoutDate, nil
+}
+
+func translateIn(in *p.BlackoutDate) (*BlackoutDate, error) {
+	bod := BlackoutDate{}
+	bod.Id = int(in.Id)
+	bod.Name.Scan(in.Name)
+	bod.StartDate.Scan(in.StartDate)
+	bod.EndDate.Scan(in.EndDate)
+	return &bod, nil
+}
+
+// found these are slower; deprecated; keep them, just in case
+func translateJsonOut(bod *BlackoutDate) (*p.BlackoutDate, error) {
+	protoBlackoutDate := p.BlackoutDate{}
+	outBytes, err := json.Marshal(bod)
+	if err != nil {
+		return &protoBlackoutDate, ae.GeneralError("Unable to encode from BlackoutDate", err)
+	}
+	err = json.Unmarshal(outBytes, &protoBlackoutDate)
+	if err != nil {
+		return &protoBlackoutDate, ae.GeneralError("Unable to decode to proto.BlackoutDate", err)
+	}
+	return &protoBlackoutDate, nil
+}
+
+func translateJsonIn(in *p.BlackoutDate) (*BlackoutDate, error) {
+	bod := BlackoutDate{}
+	outBytes, err := json.Marshal(in)
+	if err != nil {
+		return &bod, ae.GeneralError("Unable to encode from proto.BlackoutDate", err)
+	}
+	err = json.Unmarshal(outBytes, &bod)
+	if err != nil {
+		return &bod, ae.GeneralError("Unable to decode to BlackoutDate", err)
+	}
+	return &bod, nil
+}
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package blackoutdate is a generated GoMock package.
+package blackoutdate
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataBlackoutDateV1Adapter is a mock of DataBlackoutDateV1Adapter interface.
+type MockDataBlackoutDateV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataBlackoutDateV1AdapterMockRecorder
+}
+
+// MockDataBlackoutDateV1AdapterMockRecorder is the mock recorder for MockDataBlackoutDateV1Adapter.
+type MockDataBlackoutDateV1AdapterMockRecorder struct {
+	mock *MockDataBlackoutDateV1Adapter
+}
+
+// NewMockDataBlackoutDateV1Adapter creates a new mock instance.
+func NewMockDataBlackoutDateV1Adapter(ctrl *gomock.Controller) *MockDataBlackoutDateV1Adapter {
+	mock := &MockDataBlackoutDateV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataBlackoutDateV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataBlackoutDateV1Adapter) EXPECT() *MockDataBlackoutDateV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDataBlackoutDateV1Adapter) Create(arg0 context.Context, arg1 *BlackoutDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataBlackoutDateV1AdapterMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataBlackoutDateV1Adapter)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDataBlackoutDateV1Adapter) Delete(arg0 context.Context, arg1 *BlackoutDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataBlackoutDateV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataBlackoutDateV1Adapter)(nil).Delete), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockDataBlackoutDateV1Adapter) List(arg0 context.Context, arg1 *[]BlackoutDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockDataBlackoutDateV1AdapterMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDataBlackoutDateV1Adapter)(nil).List), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockDataBlackoutDateV1Adapter) Read(arg0 context.Context, arg1 *BlackoutDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataBlackoutDateV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataBlackoutDateV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataBlackoutDateV1Adapter) ReadAll(arg0 context.Context, arg1 *[]BlackoutDate, arg2 BlackoutDateParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataBlackoutDateV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataBlackoutDateV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockDataBlackoutDateV1Adapter) Update(arg0 context.Context, arg1 BlackoutDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataBlackoutDateV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataBlackoutDateV1Adapter)(nil).Update), arg0, arg1)
+}
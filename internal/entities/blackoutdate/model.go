@@ -0,0 +1,60 @@
+package blackoutdate
+
+import (
+	"time"
+
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	// BlackoutDate is a day or run of days nothing is scheduled on, e.g.: a holiday or stake conference
+	BlackoutDate struct {
+		Id        int         `db:"id" json:"id"`
+		Name      null.String `db:"name" json:"name"`
+		StartDate null.String `db:"start_date" json:"start_date"` // YYYY-MM-DD
+		EndDate   null.String `db:"end_date" json:"end_date"`     // YYYY-MM-DD (inclusive), defaults to start_date
+	}
+
+	BlackoutDateParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+)
+
+const (
+	BlackoutDateConst = "blackout_date"
+	layoutDate        = "2006-01-02"
+)
+
+func InitStorageV1() DataBlackoutDateV1Adapter {
+	return InitSQLV1()
+}
+
+// Bounds is when the blackout starts and ends (exclusive), the dates are days in tz
+func (b BlackoutDate) Bounds(tz *time.Location) (start, end time.Time) {
+	start = localDay(b.StartDate.String, tz)
+	end = localDay(b.EndDate.String, tz).AddDate(0, 0, 1)
+	return start.UTC(), end.UTC()
+}
+
+// Contains is whether t falls on one of the blackout's days
+func (b BlackoutDate) Contains(t time.Time, tz *time.Location) bool {
+	start, end := b.Bounds(tz)
+	return !t.Before(start) && t.Before(end)
+}
+
+// For is the blackout t falls on, false => none of them
+func For(blackouts []BlackoutDate, t time.Time, tz *time.Location) (BlackoutDate, bool) {
+	for _, b := range blackouts {
+		if b.Contains(t, tz) {
+			return b, true
+		}
+	}
+	return BlackoutDate{}, false
+}
+
+func localDay(date string, tz *time.Location) time.Time {
+	day, _ := time.ParseInLocation(layoutDate, date, tz)
+	return day
+}
@@ -0,0 +1,157 @@
+package blackoutdate
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestBlackoutDateV1 struct{}
+)
+
+var (
+	restV1   RestBlackoutDateV1
+	domainV1 *DomainBlackoutDateV1
+)
+
+func InitializeBlackoutDateV1() *DomainBlackoutDateV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainBlackoutDateV1(storV1)
+	restV1 = *NewRestBlackoutDateV1()
+	return domainV1
+}
+
+func RegisterBlackoutDate(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/blackout-date/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/blackout-date/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/blackout-date", Post)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/blackout-date", Patch)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/blackout-date/:id", Delete)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Post(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Post(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Patch(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Patch(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Delete(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Delete(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestBlackoutDateV1() *RestBlackoutDateV1 {
+	return &RestBlackoutDateV1{}
+}
+
+func (h *RestBlackoutDateV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	blackoutDate := &BlackoutDate{Id: int(id)}
+	if err := domainV1.Get(ctx, blackoutDate); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *blackoutDate, nil)
+}
+
+func (h *RestBlackoutDateV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := BlackoutDateParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	blackoutDates := &[]BlackoutDate{}
+	totalCount, err := domainV1.Search(ctx, blackoutDates, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *blackoutDates, &totalCount)
+}
+
+func (h *RestBlackoutDateV1) Post(c echo.Context) error {
+	ctx := context.Background()
+	bod := BlackoutDate{}
+	if err := c.Bind(&bod); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Post(ctx, &bod); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, bod, nil)
+}
+
+func (h *RestBlackoutDateV1) Patch(c echo.Context) error {
+	ctx := context.Background()
+	bod := BlackoutDate{}
+	if err := c.Bind(&bod); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Patch(ctx, bod); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestBlackoutDateV1) Delete(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	blackoutDate := &BlackoutDate{Id: int(id)}
+	if err := domainV1.Delete(ctx, blackoutDate); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
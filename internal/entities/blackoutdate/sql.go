@@ -0,0 +1,135 @@
+package blackoutdate
+
+import (
+	"context"
+	"fmt"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLBlackoutDateV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLBlackoutDateV1 {
+	db := stor.InitStorage()
+	return &SQLBlackoutDateV1{DB: db}
+}
+
+func (d *SQLBlackoutDateV1) Read(ctx context.Context, bod *BlackoutDate) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			start_date,
+			end_date
+		FROM blackout_date WHERE id = $1`
+	if errDB := d.DB.Get(bod, sqlGet, bod.Id); errDB != nil {
+		return ae.DBError("BlackoutDate Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLBlackoutDateV1) ReadAll(ctx context.Context, bod *[]BlackoutDate, param BlackoutDateParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			start_date,
+			end_date
+		FROM blackout_date
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(bod, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("BlackoutDate ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM blackout_date
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("blackout_date ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLBlackoutDateV1) Create(ctx context.Context, bod *BlackoutDate) error {
+	count, errCount := d.count()
+	if errCount != nil {
+		return errCount
+	}
+	bod.Id = count
+	sqlPost := `
+		INSERT INTO blackout_date (
+			id,
+			name,
+			start_date,
+			end_date
+		) VALUES (
+			:id,
+			:name,
+			:start_date,
+			:end_date
+		)`
+	_, errDB := d.DB.NamedExec(sqlPost, bod)
+	if errDB != nil {
+		return ae.DBError("BlackoutDate Post: unable to insert record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLBlackoutDateV1) Update(ctx context.Context, bod BlackoutDate) error {
+	sqlPatch := `
+		UPDATE blackout_date SET
+			name = :name,
+			start_date = :start_date,
+			end_date = :end_date
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, bod); errDB != nil {
+		return ae.DBError("BlackoutDate Patch: unable to update record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLBlackoutDateV1) Delete(ctx context.Context, bod *BlackoutDate) error {
+	sqlDelete := `
+		DELETE FROM blackout_date WHERE id = $1`
+	if _, errDB := d.DB.Exec(sqlDelete, bod.Id); errDB != nil {
+		return ae.DBError("BlackoutDate Delete: unable to delete record.", errDB)
+	}
+	return nil
+}
+
+// every blackout, earliest first
+func (d *SQLBlackoutDateV1) List(ctx context.Context, bod *[]BlackoutDate) error {
+	sqlList := `
+		SELECT
+			id,
+			name,
+			start_date,
+			end_date
+		FROM blackout_date
+		ORDER BY start_date`
+	if errDB := d.DB.Select(bod, sqlList); errDB != nil {
+		return ae.DBError("BlackoutDate List: unable to select records.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLBlackoutDateV1) count() (int, error) {
+	count := 0
+	if errDB := d.DB.Get(&count, "SELECT COALESCE(MAX(id), 0) FROM blackout_date"); errDB != nil {
+		return 0, ae.DBError("BlackoutDate count: unable to get count.", errDB)
+	}
+	return count + 1, nil
+}
@@ -0,0 +1,43 @@
+package emailcapture
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
+)
+
+type (
+	// Capturer is what the memory transport keeps, only filled in when TITHE_DECLARE_EMAIL_TRANSPORT is memory
+	Capturer interface {
+		Messages() []email.SentMessage
+		Reset()
+	}
+
+	DomainEmailCaptureV1 struct {
+		capture Capturer
+	}
+)
+
+func NewDomainEmailCaptureV1(capture Capturer) *DomainEmailCaptureV1 {
+	return &DomainEmailCaptureV1{capture: capture}
+}
+
+func (m *DomainEmailCaptureV1) List(ctx context.Context, param EmailCaptureParam) []EmailCapture {
+	captured := m.capture.Messages()
+	if param.To == "" {
+		return captured
+	}
+	filtered := []EmailCapture{}
+	for _, ec := range captured {
+		if slices.ContainsFunc(ec.To, func(to string) bool { return strings.EqualFold(to, param.To) }) {
+			filtered = append(filtered, ec)
+		}
+	}
+	return filtered
+}
+
+func (m *DomainEmailCaptureV1) Clear(ctx context.Context) {
+	m.capture.Reset()
+}
@@ -0,0 +1,12 @@
+package emailcapture
+
+import "github.com/blackflagsoftware/tithe-declare/internal/util/email"
+
+type (
+	// EmailCapture is a message the memory email transport would have sent
+	EmailCapture = email.SentMessage
+
+	EmailCaptureParam struct {
+		To string `query:"to"` // only messages to this address
+	}
+)
@@ -0,0 +1,72 @@
+package emailcapture
+
+import (
+	"context"
+	"net/http"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestEmailCaptureV1 struct{}
+)
+
+var (
+	restV1   RestEmailCaptureV1
+	domainV1 *DomainEmailCaptureV1
+)
+
+func InitializeEmailCaptureV1() *DomainEmailCaptureV1 {
+	domainV1 = NewDomainEmailCaptureV1(email.Capture)
+	restV1 = *NewRestEmailCaptureV1()
+	return domainV1
+}
+
+func RegisterEmailCapture(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/email-capture", List)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/email-capture", Clear)
+}
+
+func List(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.List(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Clear(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Clear(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestEmailCaptureV1() *RestEmailCaptureV1 {
+	return &RestEmailCaptureV1{}
+}
+
+// List is what has been sent, oldest first, filtered with ?to=
+func (h *RestEmailCaptureV1) List(c echo.Context) error {
+	ctx := context.Background()
+	param := EmailCaptureParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	emailCaptures := domainV1.List(ctx, param)
+	totalCount := len(emailCaptures)
+	return handler.FormatResponse(c, 200, emailCaptures, &totalCount)
+}
+
+func (h *RestEmailCaptureV1) Clear(c echo.Context) error {
+	ctx := context.Background()
+	domainV1.Clear(ctx)
+	return c.NoContent(http.StatusOK)
+}
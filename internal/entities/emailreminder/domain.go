@@ -2,15 +2,20 @@ package emailreminder
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/blackflagsoftware/tithe-declare/config"
 	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
 	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/notification"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/reminderrule"
 	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
 	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
 	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
 	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
 	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
 )
 
 //go:generate mockgen -source=domain.go -destination=mock.go -package=emailreminder
@@ -23,17 +28,33 @@ type (
 		Delete(context.Context, *EmailReminder) error
 	}
 
+	// Outbox queues the reminders, they are sent (and retried) by the notification delivery job
+	Outbox interface {
+		Enqueue(context.Context, *notification.Notification) (bool, error)
+		Search(context.Context, *[]notification.Notification, notification.NotificationParam) (int, error)
+	}
+
+	// BookingSearcher is the part of the td_date domain the reminders use to find the upcoming bookings
+	BookingSearcher interface {
+		Search(context.Context, *[]tddate.TdDate, tddate.TdDateParam) (int, error)
+	}
+
 	DomainEmailReminderV1 struct {
 		dataEmailReminderV1 DataEmailReminderV1Adapter
 		auditWriter         a.AuditAdapter
 		emailer             email.Emailer
+		outbox              Outbox
+		dataReminderRuleV1  reminderrule.DataReminderRuleV1Adapter
+		bookings            BookingSearcher
 	}
 )
 
-func NewDomainEmailReminderV1(cemaV1 DataEmailReminderV1Adapter) *DomainEmailReminderV1 {
+func NewDomainEmailReminderV1(cemaV1 DataEmailReminderV1Adapter, bookings BookingSearcher) *DomainEmailReminderV1 {
 	aw := a.AuditInit()
 	em := email.EmailInit()
-	return &DomainEmailReminderV1{dataEmailReminderV1: cemaV1, auditWriter: aw, emailer: em}
+	ob := notification.NewDomainNotificationV1(notification.InitStorageV1())
+	rr := reminderrule.InitStorageV1()
+	return &DomainEmailReminderV1{dataEmailReminderV1: cemaV1, auditWriter: aw, emailer: em, outbox: ob, dataReminderRuleV1: rr, bookings: bookings}
 }
 
 func (m *DomainEmailReminderV1) Get(ctx context.Context, ema *EmailReminder) error {
@@ -99,75 +120,223 @@ func (m *DomainEmailReminderV1) Delete(ctx context.Context, ema *EmailReminder)
 	return nil
 }
 
-func (m *DomainEmailReminderV1) SendEmail(ctx context.Context) error {
-	now := time.Now().UTC()
-	if now.Weekday() == time.Friday && now.Hour() == 23 && now.Minute() == 0 {
-		logging.Default.Println("It's Friday at 11:00 PM UTC, sending email reminders...")
-		sql := tddate.InitSQLV1()
-		tdDomain := tddate.NewDomainTdDateV1(sql)
-		param := tddate.TdDateParam{
-			Param: h.Param{
-				Search: h.Search{
-					Filters: []h.Filter{
-						{Column: "hold", Compare: "NOT NULL", Value: nil},
-						{Column: "confirm", Compare: "NOT NULL", Value: nil},
-						{Column: "date_value", Compare: ">", Value: now},
-						{Column: "date_value", Compare: "<", Value: now.AddDate(0, 0, 7)},
-					},
+// SlotBooked sends the new booking to everyone in email_reminder right away instead of waiting for the weekly reminder,
+// nothing is sent for an imported booking (see tddate.WithoutMessages)
+func (m *DomainEmailReminderV1) SlotBooked(ctx context.Context, td tddate.TdDate) {
+	if tddate.Quiet(ctx) {
+		return
+	}
+	emails := []EmailReminder{}
+	if _, err := m.Search(ctx, &emails, EmailReminderParam{}); err != nil {
+		logging.Default.Println("Error getting email reminders for td_date id", td.Id, ":", err)
+		return
+	}
+	emailAddresses := []string{}
+	for _, er := range emails {
+		if er.Email.Valid {
+			emailAddresses = append(emailAddresses, er.Email.String)
+		}
+	}
+	if len(emailAddresses) == 0 {
+		return
+	}
+	body := "A tithing declaration has been scheduled:\n\n- " + td.Appointment() + " for " + td.Name.String
+	if td.Email.Valid && td.Email.String != "" {
+		body += " (Email: " + td.Email.String + ")"
+	}
+	if td.Phone.Valid && td.Phone.String != "" {
+		body += " (Phone: " + td.Phone.String + ")"
+	}
+	m.emailer.SendConfirmation(ctx, emailAddresses, body+"\n")
+}
+
+// NoShow queues a follow-up inviting the family to book another time, see tddate.NoShowListener
+// an address gets at most TITHE_DECLARE_NO_SHOW_LIMIT follow-ups within TITHE_DECLARE_NO_SHOW_WINDOW days
+func (m *DomainEmailReminderV1) NoShow(ctx context.Context, td tddate.TdDate) {
+	if !td.Email.Valid || td.Email.String == "" {
+		return
+	}
+	limit := config.Sch.GetNoShowLimit()
+	if limit < 1 {
+		return
+	}
+	since := time.Now().UTC().AddDate(0, 0, -config.Sch.GetNoShowWindow())
+	param := notification.NotificationParam{
+		Param: h.Param{
+			Search: h.Search{
+				Filters: []h.Filter{
+					{Column: "recipient", Compare: "=", Value: td.Email.String},
+					{Column: "kind", Compare: "=", Value: notification.KindNoShowFollowUp},
+					{Column: "created_at", Compare: ">=", Value: since},
 				},
 			},
+		},
+	}
+	sent, err := m.outbox.Search(ctx, &[]notification.Notification{}, param)
+	if err != nil {
+		logging.Default.Println("Error checking no-show follow-ups for td_date id", td.Id, ":", err)
+		return
+	}
+	if sent >= limit {
+		logging.Default.Println("No-show follow-up limit reached for td_date id", td.Id)
+		return
+	}
+	followUp := &notification.Notification{
+		Recipient: td.Email,
+		TdDateId:  null.IntFrom(int64(td.Id)),
+		Kind:      null.StringFrom(notification.KindNoShowFollowUp),
+		Body:      null.StringFrom(td.Appointment()),
+	}
+	if _, err := m.outbox.Enqueue(ctx, followUp); err != nil {
+		logging.Default.Println("Error queuing no-show follow-up for td_date id", td.Id, ":", err)
+	}
+}
+
+// SendEmail checks each active reminder rule and queues what is due, run every few minutes by the scheduler
+// individual rules queue a reminder for each booking inside the rule's offset, once per rule per booking (and a text for those who opted in)
+// digest rules queue the bookings inside the rule's window to everyone in email_reminder when the rule's cron comes around
+func (m *DomainEmailReminderV1) SendEmail(ctx context.Context) error {
+	now := time.Now().UTC()
+	rules := []reminderrule.ReminderRule{}
+	param := reminderrule.ReminderRuleParam{
+		Param: h.Param{Search: h.Search{Filters: []h.Filter{{Column: "active", Compare: "=", Value: true}}}},
+	}
+	param.Param.CalculateParam("id", map[string]string{"id": "id", "active": "active"})
+	if _, err := m.dataReminderRuleV1.ReadAll(ctx, &rules, param); err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		var err error
+		switch rule.Channel.String {
+		case reminderrule.ChannelIndividual:
+			err = m.queueIndividual(ctx, rule, now)
+		case reminderrule.ChannelDigest:
+			err = m.queueDigest(ctx, rule, now)
 		}
-		param.Param.CalculateParam("date_value", map[string]string{"id": "id", "date_value": "date_value", "hold": "hold", "confirm": "confirm", "name": "name", "phone": "phone", "email": "email"})
-		tdDates := []tddate.TdDate{}
-		_, err := tdDomain.Search(ctx, &tdDates, param)
 		if err != nil {
 			return err
 		}
-		if len(tdDates) == 0 {
-			logging.Default.Println("No upcoming declaration dates found, no emails to send.")
-			return nil
-		}
-		emailBody := "The following upcoming declaration dates have been scheduled:\n\n"
-		for _, td := range tdDates {
-			individualBody := "This is a reminder that you have an upcoming tithing declaration date scheduled for:\n\n"
-			emailBody += "- "
-			if td.DateValue.Valid {
-				emailBody += td.DateValue.Time.Format("01/02/2006")
-				individualBody += td.DateValue.Time.Format("Monday, January 2, 2006")
-			} else {
-				emailBody += "No Date"
-			}
-			emailBody += " for "
-			if td.Name.Valid {
-				emailBody += td.Name.String
-			} else {
-				emailBody += "No Name"
+	}
+	return nil
+}
+
+func (m *DomainEmailReminderV1) queueIndividual(ctx context.Context, rule reminderrule.ReminderRule, now time.Time) error {
+	tdDates, err := m.upcoming(ctx, now, time.Duration(rule.OffsetMinutes.Int64)*time.Minute)
+	if err != nil {
+		return err
+	}
+	for _, td := range tdDates {
+		if td.SmsOptIn.Bool && td.Phone.String != "" {
+			text := &notification.Notification{
+				Recipient: td.Phone,
+				TdDateId:  null.IntFrom(int64(td.Id)),
+				Kind:      null.StringFrom(notification.KindSmsReminder),
+				DedupeKey: null.StringFrom(fmt.Sprintf("reminder-rule-%d:%d:%s", rule.Id, td.Id, td.Phone.String)),
+				Body:      null.StringFrom(reminderData(td).Appointment),
 			}
-			if td.Email.Valid {
-				emailBody += " (Email: " + td.Email.String + ")"
-				if td.Email.String != "" {
-					go func(emailAddr string, body string) {
-						m.emailer.SendIndividualReminder(ctx, []string{emailAddr}, body)
-					}(td.Email.String, individualBody)
-				}
+			if _, err := m.outbox.Enqueue(ctx, text); err != nil {
+				return err
 			}
-			emailBody += "\n"
 		}
-		emails := []EmailReminder{}
-		if _, err := m.Search(ctx, &emails, EmailReminderParam{}); err != nil {
+		if !td.Email.Valid || td.Email.String == "" {
+			continue
+		}
+		body, err := rule.RenderIndividual(reminderData(td))
+		if err != nil {
 			return err
 		}
-		if len(emails) == 0 {
-			logging.Default.Println("No email reminders configured, no emails to send.")
-			return nil
+		// queued once per rule per booking, running the reminders again doesn't send it twice
+		reminder := &notification.Notification{
+			Recipient: td.Email,
+			TdDateId:  null.IntFrom(int64(td.Id)),
+			Kind:      null.StringFrom(notification.KindIndividualReminder),
+			DedupeKey: null.StringFrom(fmt.Sprintf("reminder-rule-%d:%d:%s", rule.Id, td.Id, td.Email.String)),
+			Body:      null.StringFrom(body),
+			Calendar:  null.StringFrom(string(td.BookingCalendar())),
 		}
-		emailAddresses := []string{}
-		for _, er := range emails {
-			if er.Email.Valid {
-				emailAddresses = append(emailAddresses, er.Email.String)
-			}
+		if _, err := m.outbox.Enqueue(ctx, reminder); err != nil {
+			return err
 		}
-		m.emailer.SendReminder(ctx, emailAddresses, emailBody)
 	}
 	return nil
 }
+
+func (m *DomainEmailReminderV1) queueDigest(ctx context.Context, rule reminderrule.ReminderRule, now time.Time) error {
+	local := now.In(config.Sch.GetTimezone())
+	if !rule.LastRun.Valid {
+		// a new digest starts counting from now, it goes out the next time its cron comes around
+		rule.LastRun = null.TimeFrom(now)
+		return m.dataReminderRuleV1.SetLastRun(ctx, rule)
+	}
+	if !rule.DigestDue(now, local.Location()) {
+		return nil
+	}
+	logging.Default.Println("Sending reminder digest:", rule.Name.String)
+	tdDates, err := m.upcoming(ctx, now, time.Duration(rule.OffsetMinutes.Int64)*time.Minute)
+	if err != nil {
+		return err
+	}
+	rule.LastRun = null.TimeFrom(now)
+	if len(tdDates) == 0 {
+		logging.Default.Println("No upcoming declaration dates found, no digest to send.")
+		return m.dataReminderRuleV1.SetLastRun(ctx, rule)
+	}
+	bookings := make([]reminderrule.ReminderData, len(tdDates))
+	for i := range tdDates {
+		bookings[i] = reminderData(tdDates[i])
+	}
+	body, err := rule.RenderDigest(bookings)
+	if err != nil {
+		return err
+	}
+	emails := []EmailReminder{}
+	if _, err := m.Search(ctx, &emails, EmailReminderParam{}); err != nil {
+		return err
+	}
+	for _, er := range emails {
+		if !er.Email.Valid || er.Email.String == "" {
+			continue
+		}
+		// one digest per rule per address per day
+		digest := &notification.Notification{
+			Recipient: er.Email,
+			Kind:      null.StringFrom(notification.KindReminderDigest),
+			DedupeKey: null.StringFrom(fmt.Sprintf("reminder-rule-%d:%s:%s", rule.Id, local.Format("2006-01-02"), er.Email.String)),
+			Body:      null.StringFrom(body),
+		}
+		if _, err := m.outbox.Enqueue(ctx, digest); err != nil {
+			return err
+		}
+	}
+	return m.dataReminderRuleV1.SetLastRun(ctx, rule)
+}
+
+// upcoming is the confirmed bookings from now up to and including now + within
+func (m *DomainEmailReminderV1) upcoming(ctx context.Context, now time.Time, within time.Duration) ([]tddate.TdDate, error) {
+	param := tddate.TdDateParam{
+		Param: h.Param{
+			Search: h.Search{
+				Filters: []h.Filter{
+					{Column: "hold", Compare: "NOT NULL", Value: nil},
+					{Column: "confirm", Compare: "NOT NULL", Value: nil},
+					{Column: "date_value", Compare: ">", Value: now},
+					{Column: "date_value", Compare: "<=", Value: now.Add(within)},
+				},
+			},
+		},
+	}
+	param.Param.CalculateParam("date_value", map[string]string{"id": "id", "date_value": "date_value", "end_value": "end_value", "hold": "hold", "confirm": "confirm", "name": "name", "phone": "phone", "email": "email"})
+	tdDates := []tddate.TdDate{}
+	if _, err := m.bookings.Search(ctx, &tdDates, param); err != nil {
+		return nil, err
+	}
+	return tdDates, nil
+}
+
+func reminderData(td tddate.TdDate) reminderrule.ReminderData {
+	appointment := "No Date"
+	if td.DateValue.Valid {
+		appointment = td.Appointment()
+	}
+	return reminderrule.ReminderData{Name: td.Name.String, Email: td.Email.String, Phone: td.Phone.String, Appointment: appointment}
+}
@@ -0,0 +1,85 @@
+package emailreminder
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/notification"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/reminderrule"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDomainEmailReminderV1_NoShow(t *testing.T) {
+	ctx := context.TODO()
+	defer func(limit, window string) { config.Sch.NoShowLimit, config.Sch.NoShowWindow = limit, window }(config.Sch.NoShowLimit, config.Sch.NoShowWindow)
+	config.Sch.NoShowWindow = "30"
+
+	missed := tddate.TdDate{Id: 4, Email: null.StringFrom("smith@example.com"), DateValue: null.TimeFrom(time.Date(2025, 12, 7, 16, 0, 0, 0, time.UTC)), EndValue: null.TimeFrom(time.Date(2025, 12, 7, 16, 15, 0, 0, time.UTC))}
+	tests := []struct {
+		name      string
+		td        tddate.TdDate
+		limit     string
+		sent      int
+		wantQueue bool
+	}{
+		{"queued", missed, "1", 0, true},
+		{"queued - under the limit", missed, "3", 2, true},
+		{"limit reached", missed, "1", 1, false},
+		{"follow-ups turned off", missed, "0", 0, false},
+		{"no email", tddate.TdDate{Id: 5}, "1", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Sch.NoShowLimit = tt.limit
+			ctrl := gomock.NewController(t)
+			mockOutbox := NewMockOutbox(ctrl)
+			mockOutbox.EXPECT().Search(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *[]notification.Notification, param notification.NotificationParam) (int, error) {
+				assert.Equal(t, "smith@example.com", param.Search.Filters[0].Value)
+				assert.Equal(t, notification.KindNoShowFollowUp, param.Search.Filters[1].Value)
+				since := param.Search.Filters[2].Value.(time.Time)
+				assert.WithinDuration(t, time.Now().UTC().AddDate(0, 0, -30), since, time.Minute)
+				return tt.sent, nil
+			}).AnyTimes()
+			if tt.wantQueue {
+				mockOutbox.EXPECT().Enqueue(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, not *notification.Notification) (bool, error) {
+					assert.Equal(t, notification.KindNoShowFollowUp, not.Kind.String)
+					assert.Equal(t, int64(4), not.TdDateId.Int64)
+					assert.Equal(t, missed.Appointment(), not.Body.String)
+					return true, nil
+				}).Times(1)
+			}
+			m := &DomainEmailReminderV1{outbox: mockOutbox}
+			m.NoShow(ctx, tt.td)
+		})
+	}
+}
+
+func TestDomainEmailReminderV1_QueueIndividual(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockOutbox := NewMockOutbox(ctrl)
+	mockBookings := NewMockBookingSearcher(ctrl)
+
+	now := time.Date(2025, 12, 6, 16, 0, 0, 0, time.UTC)
+	booked := tddate.TdDate{Id: 4, Name: null.StringFrom("Smith Family"), Email: null.StringFrom("smith@example.com"), Phone: null.StringFrom("+18015550123"), SmsOptIn: null.BoolFrom(true), DateValue: null.TimeFrom(now.Add(24 * time.Hour)), EndValue: null.TimeFrom(now.Add(24*time.Hour + 15*time.Minute))}
+	mockBookings.EXPECT().Search(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tdDates *[]tddate.TdDate, param tddate.TdDateParam) (int, error) {
+		assert.Equal(t, now.Add(24*time.Hour), param.Search.Filters[3].Value)
+		*tdDates = []tddate.TdDate{booked}
+		return 1, nil
+	})
+	queued := []string{}
+	mockOutbox.EXPECT().Enqueue(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, not *notification.Notification) (bool, error) {
+		queued = append(queued, not.DedupeKey.String)
+		return true, nil
+	}).Times(2)
+
+	m := &DomainEmailReminderV1{outbox: mockOutbox, bookings: mockBookings}
+	rule := reminderrule.ReminderRule{Id: 1, Channel: null.StringFrom(reminderrule.ChannelIndividual), OffsetMinutes: null.IntFrom(24 * 60)}
+	assert.Nil(t, m.queueIndividual(ctx, rule, now))
+	assert.Equal(t, []string{"reminder-rule-1:4:+18015550123", "reminder-rule-1:4:smith@example.com"}, queued)
+}
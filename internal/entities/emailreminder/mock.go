@@ -8,6 +8,8 @@ import (
 	context "context"
 	reflect "reflect"
 
+	notification "github.com/blackflagsoftware/tithe-declare/internal/entities/notification"
+	tddate "github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -104,3 +106,94 @@ func (mr *MockDataEmailReminderV1AdapterMockRecorder) Update(arg0, arg1 interfac
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataEmailReminderV1Adapter)(nil).Update), arg0, arg1)
 }
+
+// MockOutbox is a mock of Outbox interface.
+type MockOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxMockRecorder
+}
+
+// MockOutboxMockRecorder is the mock recorder for MockOutbox.
+type MockOutboxMockRecorder struct {
+	mock *MockOutbox
+}
+
+// NewMockOutbox creates a new mock instance.
+func NewMockOutbox(ctrl *gomock.Controller) *MockOutbox {
+	mock := &MockOutbox{ctrl: ctrl}
+	mock.recorder = &MockOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutbox) EXPECT() *MockOutboxMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockOutbox) Enqueue(arg0 context.Context, arg1 *notification.Notification) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockOutboxMockRecorder) Enqueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockOutbox)(nil).Enqueue), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockOutbox) Search(arg0 context.Context, arg1 *[]notification.Notification, arg2 notification.NotificationParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockOutboxMockRecorder) Search(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockOutbox)(nil).Search), arg0, arg1, arg2)
+}
+
+// MockBookingSearcher is a mock of BookingSearcher interface.
+type MockBookingSearcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockBookingSearcherMockRecorder
+}
+
+// MockBookingSearcherMockRecorder is the mock recorder for MockBookingSearcher.
+type MockBookingSearcherMockRecorder struct {
+	mock *MockBookingSearcher
+}
+
+// NewMockBookingSearcher creates a new mock instance.
+func NewMockBookingSearcher(ctrl *gomock.Controller) *MockBookingSearcher {
+	mock := &MockBookingSearcher{ctrl: ctrl}
+	mock.recorder = &MockBookingSearcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBookingSearcher) EXPECT() *MockBookingSearcherMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method.
+func (m *MockBookingSearcher) Search(arg0 context.Context, arg1 *[]tddate.TdDate, arg2 tddate.TdDateParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockBookingSearcherMockRecorder) Search(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockBookingSearcher)(nil).Search), arg0, arg1, arg2)
+}
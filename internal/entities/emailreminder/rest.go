@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
 	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
 	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
 	"github.com/labstack/echo/v4"
@@ -20,10 +21,12 @@ var (
 	domainV1 *DomainEmailReminderV1
 )
 
-func InitializeEmailReminderV1() *DomainEmailReminderV1 {
+func InitializeEmailReminderV1(tdDomain *tddate.DomainTdDateV1) *DomainEmailReminderV1 {
 	storV1 := InitStorageV1()
-	domainV1 = NewDomainEmailReminderV1(storV1)
+	domainV1 = NewDomainEmailReminderV1(storV1, tdDomain)
 	restV1 = *NewRestEmailReminderV1()
+	tdDomain.AddSlotBookListener(domainV1)
+	tdDomain.AddNoShowListener(domainV1)
 	return domainV1
 }
 
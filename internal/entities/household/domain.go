@@ -0,0 +1,194 @@
+package household
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/householdmember"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/validate"
+	"gopkg.in/guregu/null.v3"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=household
+type (
+	DataHouseholdV1Adapter interface {
+		Read(context.Context, *Household) error
+		ReadAll(context.Context, *[]Household, HouseholdParam) (int, error)
+		Create(context.Context, *Household) error
+		Update(context.Context, Household) error
+		Delete(context.Context, *Household) error
+		Match(context.Context, string, string) (int, error)
+		ReadUnscheduled(context.Context, *[]Household, time.Time, time.Time) error
+	}
+
+	// TdDateLinker sets the household on a confirmed booking, see tddate.DomainTdDateV1.LinkHousehold
+	TdDateLinker interface {
+		LinkHousehold(context.Context, tddate.TdDate) error
+	}
+
+	DomainHouseholdV1 struct {
+		dataHouseholdV1       DataHouseholdV1Adapter
+		dataHouseholdMemberV1 householdmember.DataHouseholdMemberV1Adapter
+		linker                TdDateLinker
+		auditWriter           a.AuditAdapter
+	}
+)
+
+func NewDomainHouseholdV1(chhV1 DataHouseholdV1Adapter, chmV1 householdmember.DataHouseholdMemberV1Adapter, linker TdDateLinker) *DomainHouseholdV1 {
+	aw := a.AuditInit()
+	return &DomainHouseholdV1{dataHouseholdV1: chhV1, dataHouseholdMemberV1: chmV1, linker: linker, auditWriter: aw}
+}
+
+// Get includes the household's members
+func (m *DomainHouseholdV1) Get(ctx context.Context, hh *Household) error {
+	if hh.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataHouseholdV1.Read(ctx, hh); err != nil {
+		return err
+	}
+	param := householdmember.HouseholdMemberParam{
+		Param: h.Param{
+			Search: h.Search{
+				Filters: []h.Filter{{Column: "household_id", Compare: "=", Value: hh.Id}},
+			},
+		},
+	}
+	param.Param.CalculateParam("name", map[string]string{"household_id": "household_id", "name": "name"})
+	hh.Members = []householdmember.HouseholdMember{}
+	_, err := m.dataHouseholdMemberV1.ReadAll(ctx, &hh.Members, param)
+	return err
+}
+
+func (m *DomainHouseholdV1) Search(ctx context.Context, hh *[]Household, param HouseholdParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("name", map[string]string{"id": "id", "name": "name", "email": "email", "phone": "phone", "address": "address", "active": "active"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataHouseholdV1.ReadAll(ctx, hh, param)
+}
+
+func (m *DomainHouseholdV1) Post(ctx context.Context, hh *Household) error {
+	if err := validateContact(hh, true); err != nil {
+		return err
+	}
+	if !hh.Active.Valid {
+		hh.Active = null.BoolFrom(true)
+	}
+	if err := m.dataHouseholdV1.Create(ctx, hh); err != nil {
+		return err
+	}
+	go a.AuditCreate(m.auditWriter, *hh, HouseholdConst, a.KeysToString("id", hh.Id))
+	return nil
+}
+
+func (m *DomainHouseholdV1) Patch(ctx context.Context, hhIn Household) error {
+	hh := &Household{Id: hhIn.Id}
+	errGet := m.dataHouseholdV1.Read(ctx, hh)
+	if errGet != nil {
+		return errGet
+	}
+	if err := validateContact(&hhIn, false); err != nil {
+		return err
+	}
+	existingValues := make(map[string]any)
+	// Name
+	if hhIn.Name.Valid {
+		existingValues["name"] = hh.Name.String
+		hh.Name = hhIn.Name
+	}
+	// Email
+	if hhIn.Email.Valid {
+		existingValues["email"] = hh.Email.String
+		hh.Email = hhIn.Email
+	}
+	// Phone
+	if hhIn.Phone.Valid {
+		existingValues["phone"] = hh.Phone.String
+		hh.Phone = hhIn.Phone
+	}
+	// Address
+	if hhIn.Address.Valid {
+		existingValues["address"] = hh.Address.String
+		hh.Address = hhIn.Address
+	}
+	// Active
+	if hhIn.Active.Valid {
+		existingValues["active"] = hh.Active.Bool
+		hh.Active = hhIn.Active
+	}
+	if err := m.dataHouseholdV1.Update(ctx, *hh); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *hh, HouseholdConst, a.KeysToString("id", hh.Id), existingValues)
+	return nil
+}
+
+func (m *DomainHouseholdV1) Delete(ctx context.Context, hh *Household) error {
+	if hh.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataHouseholdV1.Delete(ctx, hh); err != nil {
+		return err
+	}
+	go a.AuditDelete(m.auditWriter, *hh, HouseholdConst, a.KeysToString("id", hh.Id))
+	return nil
+}
+
+// Unscheduled is the active households without a confirmed declaration in year (0 => this year), in the unit's timezone
+func (m *DomainHouseholdV1) Unscheduled(ctx context.Context, hh *[]Household, year int) error {
+	tz := config.Sch.GetTimezone()
+	if year == 0 {
+		year = time.Now().In(tz).Year()
+	}
+	if year < 1900 || year > 9999 {
+		return ae.ParseError("year must be between 1900 and 9999")
+	}
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, tz)
+	end := start.AddDate(1, 0, 0)
+	return m.dataHouseholdV1.ReadUnscheduled(ctx, hh, start.UTC(), end.UTC())
+}
+
+// SlotBooked links the confirmed booking to the household with the same email or phone, see tddate.SlotBookListener
+func (m *DomainHouseholdV1) SlotBooked(ctx context.Context, td tddate.TdDate) {
+	if td.HouseholdId.Valid {
+		return
+	}
+	id, err := m.dataHouseholdV1.Match(ctx, td.Email.ValueOrZero(), td.Phone.ValueOrZero())
+	if err != nil {
+		logging.Default.Println("Error matching a household for td_date id", td.Id, ":", err)
+		return
+	}
+	if id == 0 {
+		return
+	}
+	td.HouseholdId = null.IntFrom(int64(id))
+	if err := m.linker.LinkHousehold(ctx, td); err != nil {
+		logging.Default.Println("Error linking household", id, "to td_date id", td.Id, ":", err)
+	}
+}
+
+// validateContact trims the name, email and phone and checks them all at once, the phone is normalized to E.164
+func validateContact(hh *Household, requireName bool) error {
+	v := &validate.Validator{}
+	if requireName {
+		v.Required("name", &hh.Name)
+	} else {
+		validate.Trim(&hh.Name)
+	}
+	v.MaxLength("name", hh.Name, 100)
+	v.Email("email", &hh.Email)
+	v.MaxLength("email", hh.Email, 100)
+	v.Phone("phone", &hh.Phone, config.S.DefaultCountry)
+	validate.Trim(&hh.Address)
+	v.MaxLength("address", hh.Address, 255)
+	return v.Err()
+}
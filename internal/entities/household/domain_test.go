@@ -0,0 +1,110 @@
+package household
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDomainHouseholdV1_SlotBooked(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataHousehold := NewMockDataHouseholdV1Adapter(ctrl)
+	mockLinker := NewMockTdDateLinker(ctrl)
+
+	tests := []struct {
+		name  string
+		td    tddate.TdDate
+		calls []*gomock.Call
+	}{
+		{
+			"linked by email",
+			tddate.TdDate{Id: 4, Email: null.StringFrom("Smith@Example.com")},
+			[]*gomock.Call{
+				mockDataHousehold.EXPECT().Match(ctx, "Smith@Example.com", "").Return(2, nil).Times(1),
+				mockLinker.EXPECT().LinkHousehold(ctx, tddate.TdDate{Id: 4, Email: null.StringFrom("Smith@Example.com"), HouseholdId: null.IntFrom(2)}).Return(nil).Times(1),
+			},
+		},
+		{
+			"linked by phone",
+			tddate.TdDate{Id: 5, Phone: null.StringFrom("+18015550100")},
+			[]*gomock.Call{
+				mockDataHousehold.EXPECT().Match(ctx, "", "+18015550100").Return(3, nil).Times(1),
+				mockLinker.EXPECT().LinkHousehold(ctx, tddate.TdDate{Id: 5, Phone: null.StringFrom("+18015550100"), HouseholdId: null.IntFrom(3)}).Return(nil).Times(1),
+			},
+		},
+		{
+			"no match",
+			tddate.TdDate{Id: 6, Email: null.StringFrom("new@example.com")},
+			[]*gomock.Call{
+				mockDataHousehold.EXPECT().Match(ctx, "new@example.com", "").Return(0, nil).Times(1),
+			},
+		},
+		{
+			"already linked",
+			tddate.TdDate{Id: 7, Email: null.StringFrom("smith@example.com"), HouseholdId: null.IntFrom(2)},
+			[]*gomock.Call{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DomainHouseholdV1{dataHouseholdV1: mockDataHousehold, linker: mockLinker}
+			m.SlotBooked(ctx, tt.td)
+		})
+	}
+}
+
+func TestDomainHouseholdV1_Unscheduled(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataHousehold := NewMockDataHouseholdV1Adapter(ctrl)
+
+	tz := config.Sch.GetTimezone()
+	tests := []struct {
+		name      string
+		year      int
+		wantStart time.Time
+		wantErr   bool
+	}{
+		{
+			"given year",
+			2025,
+			time.Date(2025, time.January, 1, 0, 0, 0, 0, tz),
+			false,
+		},
+		{
+			"this year",
+			0,
+			time.Date(time.Now().In(tz).Year(), time.January, 1, 0, 0, 0, 0, tz),
+			false,
+		},
+		{
+			"failed - year",
+			25,
+			time.Time{},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.wantErr {
+				mockDataHousehold.EXPECT().ReadUnscheduled(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *[]Household, start, end time.Time) error {
+						assert.True(t, tt.wantStart.Equal(start), "DomainHouseholdV1.Unscheduled().%s => start: expected: %s; got: %s", tt.name, tt.wantStart, start)
+						assert.True(t, tt.wantStart.AddDate(1, 0, 0).Equal(end), "DomainHouseholdV1.Unscheduled().%s => end: got: %s", tt.name, end)
+						assert.Equal(t, time.UTC, start.Location())
+						return nil
+					}).Times(1)
+			}
+			m := &DomainHouseholdV1{dataHouseholdV1: mockDataHousehold}
+			err := m.Unscheduled(ctx, &[]Household{}, tt.year)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainHouseholdV1.Unscheduled().%s => expected error: got: %s", tt.name, err)
+		})
+	}
+}
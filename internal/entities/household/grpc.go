@@ -0,0 +1,179 @@
+package household
+
+import (
+	"context"
+	"encoding/json"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	p "github.com/blackflagsoftware/tithe-declare/pkg/proto"
+)
+
+type (
+	HouseholdGrpc struct {
+		p.UnimplementedHouseholdServiceServer
+		domainHousehold DomainHouseholdV1
+	}
+)
+
+func NewHouseholdGrpc(mhh DomainHouseholdV1) *HouseholdGrpc {
+	return &HouseholdGrpc{domainHousehold: mhh}
+}
+
+func (a *HouseholdGrpc) GetHousehold(ctx context.Context, in *p.HouseholdIDIn) (*p.HouseholdResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.HouseholdResponse{Result: result}
+	hh := &Household{Id: int(in.Id)}
+	if err := a.domainHousehold.Get(ctx, hh); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	var err error
+	response.Household, err = translateOut(hh)
+	if err != nil {
+		return response, err
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *HouseholdGrpc) SearchHousehold(ctx context.Context, in *p.Household) (*p.HouseholdRepeatResponse, error) {
+	householdParam := HouseholdParam{}
+	result := &p.Result{Success: false}
+	response := &p.HouseholdRepeatResponse{Result: result}
+	hhs := &[]Household{}
+	if _, err := a.domainHousehold.Search(ctx, hhs, householdParam); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	for _, a := range *hhs {
+		protoHousehold, err := translateOut(&a)
+		if err != nil {
+			return response, err
+		}
+		response.Household = append(response.Household, protoHousehold)
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *HouseholdGrpc) CreateHousehold(ctx context.Context, in *p.Household) (*p.HouseholdResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.HouseholdResponse{Result: result}
+	hh, err := translateIn(in)
+	if err != nil {
+		return response, err
+	}
+	if err := a.domainHousehold.Post(ctx, hh); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	var errTranslate error
+	response.Household, errTranslate = translateOut(hh)
+	if errTranslate != nil {
+		return response, errTranslate
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *HouseholdGrpc) UpdateHousehold(ctx context.Context, in *p.Household) (*p.Result, error) {
+	response := &p.Result{Success: false}
+	hh, err := translateIn(in)
+	if err != nil {
+		return response, err
+	}
+	if err := a.domainHousehold.Patch(ctx, *hh); err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+	response.Success = true
+	return response, nil
+}
+
+func (a *HouseholdGrpc) DeleteHousehold(ctx context.Context, in *p.HouseholdIDIn) (*p.Result, error) {
+	response := &p.Result{Success: false}
+	hh := &Household{Id: int(in.Id)}
+	if err := a.domainHousehold.Delete(ctx, hh); err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+	response.Success = true
+	return response, nil
+}
+
+func (a *HouseholdGrpc) UnscheduledHousehold(ctx context.Context, in *p.UnscheduledHouseholdIn) (*p.HouseholdRepeatResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.HouseholdRepeatResponse{Result: result}
+	hhs := &[]Household{}
+	if err := a.domainHousehold.Unscheduled(ctx, hhs, int(in.Year)); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	for _, a := range *hhs {
+		protoHousehold, err := translateOut(&a)
+		if err != nil {
+			return response, err
+		}
+		response.Household = append(response.Household, protoHousehold)
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func translateOut(hh *Household) (*p.Household, error) {
+	protoHousehold := p.Household{}
+	protoHousehold.Id = int64(hh.Id)
+	protoHousehold.Name = hh.Name.String
+	protoHousehold.Email = hh.Email.String
+	protoHousehold.Phone = hh.Phone.String
+	protoHousehold.Address = hh.Address.String
+	protoHousehold.Active = hh.Active.Bool
+	for _, hm := range hh.Members {
+		protoHousehold.Members = append(protoHousehold.Members, &p.HouseholdMember{
+			Id:          int64(hm.Id),
+			HouseholdId: int64(hm.HouseholdId),
+			Name:        hm.Name.String,
+			Email:       hm.Email.String,
+			Phone:       hm.Phone.String,
+		})
+	}
+	return &protoHousehold, nil
+}
+
+func translateIn(in *p.Household) (*Household, error) {
+	hh := Household{}
+	hh.Id = int(in.Id)
+	hh.Name.Scan(in.Name)
+	hh.Email.Scan(in.Email)
+	hh.Phone.Scan(in.Phone)
+	hh.Address.Scan(in.Address)
+	hh.Active.SetValid(in.Active) // a bool can't be left out, so it is always set
+	return &hh, nil
+}
+
+// found these are slower; deprecated; keep them, just in case
+func translateJsonOut(hh *Household) (*p.Household, error) {
+	protoHousehold := p.Household{}
+	outBytes, err := json.Marshal(hh)
+	if err != nil {
+		return &protoHousehold, ae.GeneralError("Unable to encode from Household", err)
+	}
+	err = json.Unmarshal(outBytes, &protoHousehold)
+	if err != nil {
+		return &protoHousehold, ae.GeneralError("Unable to decode to proto.Household", err)
+	}
+	return &protoHousehold, nil
+}
+
+func translateJsonIn(in *p.Household) (*Household, error) {
+	hh := Household{}
+	outBytes, err := json.Marshal(in)
+	if err != nil {
+		return &hh, ae.GeneralError("Unable to encode from proto.Household", err)
+	}
+	err = json.Unmarshal(outBytes, &hh)
+	if err != nil {
+		return &hh, ae.GeneralError("Unable to decode to Household", err)
+	}
+	return &hh, nil
+}
@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package household is a generated GoMock package.
+package household
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	tddate "github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataHouseholdV1Adapter is a mock of DataHouseholdV1Adapter interface.
+type MockDataHouseholdV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataHouseholdV1AdapterMockRecorder
+}
+
+// MockDataHouseholdV1AdapterMockRecorder is the mock recorder for MockDataHouseholdV1Adapter.
+type MockDataHouseholdV1AdapterMockRecorder struct {
+	mock *MockDataHouseholdV1Adapter
+}
+
+// NewMockDataHouseholdV1Adapter creates a new mock instance.
+func NewMockDataHouseholdV1Adapter(ctrl *gomock.Controller) *MockDataHouseholdV1Adapter {
+	mock := &MockDataHouseholdV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataHouseholdV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataHouseholdV1Adapter) EXPECT() *MockDataHouseholdV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDataHouseholdV1Adapter) Create(arg0 context.Context, arg1 *Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataHouseholdV1AdapterMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataHouseholdV1Adapter)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDataHouseholdV1Adapter) Delete(arg0 context.Context, arg1 *Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataHouseholdV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataHouseholdV1Adapter)(nil).Delete), arg0, arg1)
+}
+
+// Match mocks base method.
+func (m *MockDataHouseholdV1Adapter) Match(arg0 context.Context, arg1, arg2 string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Match", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Match indicates an expected call of Match.
+func (mr *MockDataHouseholdV1AdapterMockRecorder) Match(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Match", reflect.TypeOf((*MockDataHouseholdV1Adapter)(nil).Match), arg0, arg1, arg2)
+}
+
+// Read mocks base method.
+func (m *MockDataHouseholdV1Adapter) Read(arg0 context.Context, arg1 *Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataHouseholdV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataHouseholdV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataHouseholdV1Adapter) ReadAll(arg0 context.Context, arg1 *[]Household, arg2 HouseholdParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataHouseholdV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataHouseholdV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// ReadUnscheduled mocks base method.
+func (m *MockDataHouseholdV1Adapter) ReadUnscheduled(arg0 context.Context, arg1 *[]Household, arg2, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUnscheduled", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadUnscheduled indicates an expected call of ReadUnscheduled.
+func (mr *MockDataHouseholdV1AdapterMockRecorder) ReadUnscheduled(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUnscheduled", reflect.TypeOf((*MockDataHouseholdV1Adapter)(nil).ReadUnscheduled), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockDataHouseholdV1Adapter) Update(arg0 context.Context, arg1 Household) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataHouseholdV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataHouseholdV1Adapter)(nil).Update), arg0, arg1)
+}
+
+// MockTdDateLinker is a mock of TdDateLinker interface.
+type MockTdDateLinker struct {
+	ctrl     *gomock.Controller
+	recorder *MockTdDateLinkerMockRecorder
+}
+
+// MockTdDateLinkerMockRecorder is the mock recorder for MockTdDateLinker.
+type MockTdDateLinkerMockRecorder struct {
+	mock *MockTdDateLinker
+}
+
+// NewMockTdDateLinker creates a new mock instance.
+func NewMockTdDateLinker(ctrl *gomock.Controller) *MockTdDateLinker {
+	mock := &MockTdDateLinker{ctrl: ctrl}
+	mock.recorder = &MockTdDateLinkerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTdDateLinker) EXPECT() *MockTdDateLinkerMockRecorder {
+	return m.recorder
+}
+
+// LinkHousehold mocks base method.
+func (m *MockTdDateLinker) LinkHousehold(arg0 context.Context, arg1 tddate.TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkHousehold", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LinkHousehold indicates an expected call of LinkHousehold.
+func (mr *MockTdDateLinkerMockRecorder) LinkHousehold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkHousehold", reflect.TypeOf((*MockTdDateLinker)(nil).LinkHousehold), arg0, arg1)
+}
@@ -0,0 +1,30 @@
+package household
+
+import (
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/householdmember"
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	Household struct {
+		Id      int                               `db:"id" json:"id"`
+		Name    null.String                       `db:"name" json:"name"`
+		Email   null.String                       `db:"email" json:"email"`
+		Phone   null.String                       `db:"phone" json:"phone"` // E.164
+		Address null.String                       `db:"address" json:"address"`
+		Active  null.Bool                         `db:"active" json:"active"`
+		Members []householdmember.HouseholdMember `db:"-" json:"members,omitempty"` // only filled in by Get
+	}
+
+	HouseholdParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+)
+
+const HouseholdConst = "household"
+
+func InitStorageV1() DataHouseholdV1Adapter {
+	return InitSQLV1()
+}
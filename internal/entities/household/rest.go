@@ -0,0 +1,190 @@
+package household
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/householdmember"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestHouseholdV1 struct{}
+)
+
+var (
+	restV1   RestHouseholdV1
+	domainV1 *DomainHouseholdV1
+)
+
+func InitializeHouseholdV1(tdDomain *tddate.DomainTdDateV1) *DomainHouseholdV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainHouseholdV1(storV1, householdmember.InitStorageV1(), tdDomain)
+	restV1 = *NewRestHouseholdV1()
+	tdDomain.AddSlotBookListener(domainV1)
+	return domainV1
+}
+
+func RegisterHousehold(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/household/unscheduled", Unscheduled)
+	r.RegisterAndAdd(eg, http.MethodGet, "/household/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/household/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/household", Post)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/household", Patch)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/household/:id", Delete)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Post(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Post(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Patch(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Patch(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Delete(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Delete(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Unscheduled(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Unscheduled(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestHouseholdV1() *RestHouseholdV1 {
+	return &RestHouseholdV1{}
+}
+
+func (h *RestHouseholdV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	household := &Household{Id: int(id)}
+	if err := domainV1.Get(ctx, household); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *household, nil)
+}
+
+func (h *RestHouseholdV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := HouseholdParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	households := &[]Household{}
+	totalCount, err := domainV1.Search(ctx, households, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *households, &totalCount)
+}
+
+func (h *RestHouseholdV1) Post(c echo.Context) error {
+	ctx := context.Background()
+	hh := Household{}
+	if err := c.Bind(&hh); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Post(ctx, &hh); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, hh, nil)
+}
+
+func (h *RestHouseholdV1) Patch(c echo.Context) error {
+	ctx := context.Background()
+	hh := Household{}
+	if err := c.Bind(&hh); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Patch(ctx, hh); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestHouseholdV1) Delete(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	household := &Household{Id: int(id)}
+	if err := domainV1.Delete(ctx, household); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+// Unscheduled is the households not yet scheduled in ?year= (defaults to this year)
+func (h *RestHouseholdV1) Unscheduled(c echo.Context) error {
+	ctx := context.Background()
+	year := 0
+	if yearStr := c.QueryParam("year"); yearStr != "" {
+		y, err := strconv.Atoi(yearStr)
+		if err != nil {
+			bindErr := ae.BindError(err)
+			return handler.FormatResponseWithError(c, bindErr)
+		}
+		year = y
+	}
+	households := &[]Household{}
+	if err := domainV1.Unscheduled(ctx, households, year); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	totalCount := len(*households)
+	return handler.FormatResponse(c, 200, *households, &totalCount)
+}
@@ -0,0 +1,189 @@
+package household
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLHouseholdV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLHouseholdV1 {
+	db := stor.InitStorage()
+	return &SQLHouseholdV1{DB: db}
+}
+
+func (d *SQLHouseholdV1) Read(ctx context.Context, hh *Household) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			email,
+			phone,
+			address,
+			active
+		FROM household WHERE id = $1`
+	if errDB := d.DB.Get(hh, sqlGet, hh.Id); errDB != nil {
+		return ae.DBError("Household Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLHouseholdV1) ReadAll(ctx context.Context, hh *[]Household, param HouseholdParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			email,
+			phone,
+			address,
+			active
+		FROM household
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(hh, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("Household ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM household
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("household ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLHouseholdV1) Create(ctx context.Context, hh *Household) error {
+	count, errCount := d.count()
+	if errCount != nil {
+		return errCount
+	}
+	hh.Id = count
+	sqlPost := `
+		INSERT INTO household (
+			id,
+			name,
+			email,
+			phone,
+			address,
+			active
+		) VALUES (
+			:id,
+			:name,
+			:email,
+			:phone,
+			:address,
+			:active
+		)`
+	_, errDB := d.DB.NamedExec(sqlPost, hh)
+	if errDB != nil {
+		return ae.DBError("Household Post: unable to insert record.", errDB)
+	}
+
+	return nil
+}
+
+func (d *SQLHouseholdV1) Update(ctx context.Context, hh Household) error {
+	sqlPatch := `
+		UPDATE household SET
+			name = :name,
+			email = :email,
+			phone = :phone,
+			address = :address,
+			active = :active
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, hh); errDB != nil {
+		return ae.DBError("Household Patch: unable to update record.", errDB)
+	}
+	return nil
+}
+
+// the members go with the household and any bookings are unlinked
+func (d *SQLHouseholdV1) Delete(ctx context.Context, hh *Household) (err error) {
+	txn := d.DB.MustBegin()
+	defer usql.TxnFinish(txn, &err)
+
+	if _, errDB := txn.Exec("UPDATE td_date SET household_id = NULL WHERE household_id = $1", hh.Id); errDB != nil {
+		err = ae.DBError("Household Delete: unable to unlink td_date records.", errDB)
+		return
+	}
+	if _, errDB := txn.Exec("DELETE FROM household_member WHERE household_id = $1", hh.Id); errDB != nil {
+		err = ae.DBError("Household Delete: unable to delete member records.", errDB)
+		return
+	}
+	sqlDelete := `
+		DELETE FROM household WHERE id = $1`
+	if _, errDB := txn.Exec(sqlDelete, hh.Id); errDB != nil {
+		err = ae.DBError("Household Delete: unable to delete record.", errDB)
+	}
+	return
+}
+
+// the id of the active household with email (any case) or phone, on the household or one of its members; 0 => no match
+func (d *SQLHouseholdV1) Match(ctx context.Context, email, phone string) (int, error) {
+	sqlMatch := `
+		SELECT
+			h.id
+		FROM household h
+		LEFT JOIN household_member hm ON hm.household_id = h.id
+		WHERE h.active = 1
+			AND (($1 != '' AND (LOWER(h.email) = LOWER($1) OR LOWER(hm.email) = LOWER($1)))
+				OR ($2 != '' AND (h.phone = $2 OR hm.phone = $2)))
+		ORDER BY h.id
+		LIMIT 1`
+	id := 0
+	if errDB := d.DB.Get(&id, sqlMatch, email, phone); errDB != nil {
+		if errDB == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, ae.DBError("Household Match: unable to get record.", errDB)
+	}
+	return id, nil
+}
+
+// the active households without a confirmed booking from start up to end
+func (d *SQLHouseholdV1) ReadUnscheduled(ctx context.Context, hh *[]Household, start, end time.Time) error {
+	sqlUnscheduled := `
+		SELECT
+			id,
+			name,
+			email,
+			phone,
+			address,
+			active
+		FROM household
+		WHERE active = 1
+			AND id NOT IN (
+				SELECT household_id FROM td_date
+				WHERE household_id IS NOT NULL AND confirm IS NOT NULL AND date_value >= $1 AND date_value < $2
+			)
+		ORDER BY name`
+	if errDB := d.DB.Select(hh, sqlUnscheduled, start, end); errDB != nil {
+		return ae.DBError("Household ReadUnscheduled: unable to select records.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLHouseholdV1) count() (int, error) {
+	count := 0
+	if errDB := d.DB.Get(&count, "SELECT COALESCE(MAX(id), 0) FROM household"); errDB != nil {
+		return 0, ae.DBError("Household count: unable to get count.", errDB)
+	}
+	return count + 1, nil
+}
@@ -0,0 +1,125 @@
+package householdmember
+
+import (
+	"context"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/validate"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=householdmember
+type (
+	DataHouseholdMemberV1Adapter interface {
+		Read(context.Context, *HouseholdMember) error
+		ReadAll(context.Context, *[]HouseholdMember, HouseholdMemberParam) (int, error)
+		Create(context.Context, *HouseholdMember) error
+		Update(context.Context, HouseholdMember) error
+		Delete(context.Context, *HouseholdMember) error
+	}
+
+	DomainHouseholdMemberV1 struct {
+		dataHouseholdMemberV1 DataHouseholdMemberV1Adapter
+		auditWriter           a.AuditAdapter
+	}
+)
+
+func NewDomainHouseholdMemberV1(chmV1 DataHouseholdMemberV1Adapter) *DomainHouseholdMemberV1 {
+	aw := a.AuditInit()
+	return &DomainHouseholdMemberV1{dataHouseholdMemberV1: chmV1, auditWriter: aw}
+}
+
+func (m *DomainHouseholdMemberV1) Get(ctx context.Context, hm *HouseholdMember) error {
+	if hm.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	return m.dataHouseholdMemberV1.Read(ctx, hm)
+}
+
+func (m *DomainHouseholdMemberV1) Search(ctx context.Context, hm *[]HouseholdMember, param HouseholdMemberParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("name", map[string]string{"id": "id", "household_id": "household_id", "name": "name", "email": "email", "phone": "phone"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataHouseholdMemberV1.ReadAll(ctx, hm, param)
+}
+
+func (m *DomainHouseholdMemberV1) Post(ctx context.Context, hm *HouseholdMember) error {
+	if hm.HouseholdId < 1 {
+		return ae.MissingParamError("HouseholdId")
+	}
+	if err := validateContact(hm, true); err != nil {
+		return err
+	}
+	if err := m.dataHouseholdMemberV1.Create(ctx, hm); err != nil {
+		return err
+	}
+	go a.AuditCreate(m.auditWriter, *hm, HouseholdMemberConst, a.KeysToString("id", hm.Id))
+	return nil
+}
+
+func (m *DomainHouseholdMemberV1) Patch(ctx context.Context, hmIn HouseholdMember) error {
+	hm := &HouseholdMember{Id: hmIn.Id}
+	errGet := m.dataHouseholdMemberV1.Read(ctx, hm)
+	if errGet != nil {
+		return errGet
+	}
+	if err := validateContact(&hmIn, false); err != nil {
+		return err
+	}
+	existingValues := make(map[string]any)
+	// HouseholdId
+	if hmIn.HouseholdId > 0 {
+		existingValues["household_id"] = hm.HouseholdId
+		hm.HouseholdId = hmIn.HouseholdId
+	}
+	// Name
+	if hmIn.Name.Valid {
+		existingValues["name"] = hm.Name.String
+		hm.Name = hmIn.Name
+	}
+	// Email
+	if hmIn.Email.Valid {
+		existingValues["email"] = hm.Email.String
+		hm.Email = hmIn.Email
+	}
+	// Phone
+	if hmIn.Phone.Valid {
+		existingValues["phone"] = hm.Phone.String
+		hm.Phone = hmIn.Phone
+	}
+	if err := m.dataHouseholdMemberV1.Update(ctx, *hm); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *hm, HouseholdMemberConst, a.KeysToString("id", hm.Id), existingValues)
+	return nil
+}
+
+func (m *DomainHouseholdMemberV1) Delete(ctx context.Context, hm *HouseholdMember) error {
+	if hm.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataHouseholdMemberV1.Delete(ctx, hm); err != nil {
+		return err
+	}
+	go a.AuditDelete(m.auditWriter, *hm, HouseholdMemberConst, a.KeysToString("id", hm.Id))
+	return nil
+}
+
+// validateContact trims the name, email and phone and checks them all at once, the phone is normalized to E.164
+func validateContact(hm *HouseholdMember, requireName bool) error {
+	v := &validate.Validator{}
+	if requireName {
+		v.Required("name", &hm.Name)
+	} else {
+		validate.Trim(&hm.Name)
+	}
+	v.MaxLength("name", hm.Name, 100)
+	v.Email("email", &hm.Email)
+	v.MaxLength("email", hm.Email, 100)
+	v.Phone("phone", &hm.Phone, config.S.DefaultCountry)
+	return v.Err()
+}
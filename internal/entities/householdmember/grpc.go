@@ -0,0 +1,153 @@
+package householdmember
+
+import (
+	"context"
+	"encoding/json"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	p "github.com/blackflagsoftware/tithe-declare/pkg/proto"
+)
+
+type (
+	HouseholdMemberGrpc struct {
+		p.UnimplementedHouseholdMemberServiceServer
+		domainHouseholdMember DomainHouseholdMemberV1
+	}
+)
+
+func NewHouseholdMemberGrpc(mhm DomainHouseholdMemberV1) *HouseholdMemberGrpc {
+	return &HouseholdMemberGrpc{domainHouseholdMember: mhm}
+}
+
+func (a *HouseholdMemberGrpc) GetHouseholdMember(ctx context.Context, in *p.HouseholdMemberIDIn) (*p.HouseholdMemberResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.HouseholdMemberResponse{Result: result}
+	hm := &HouseholdMember{Id: int(in.Id)}
+	if err := a.domainHouseholdMember.Get(ctx, hm); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	var err error
+	response.HouseholdMember, err = translateOut(hm)
+	if err != nil {
+		return response, err
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *HouseholdMemberGrpc) SearchHouseholdMember(ctx context.Context, in *p.HouseholdMember) (*p.HouseholdMemberRepeatResponse, error) {
+	householdMemberParam := HouseholdMemberParam{}
+	if in.HouseholdId > 0 {
+		householdMemberParam.Search.Filters = []h.Filter{{Column: "household_id", Compare: "=", Value: in.HouseholdId}}
+	}
+	result := &p.Result{Success: false}
+	response := &p.HouseholdMemberRepeatResponse{Result: result}
+	hms := &[]HouseholdMember{}
+	if _, err := a.domainHouseholdMember.Search(ctx, hms, householdMemberParam); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	for _, a := range *hms {
+		protoHouseholdMember, err := translateOut(&a)
+		if err != nil {
+			return response, err
+		}
+		response.HouseholdMember = append(response.HouseholdMember, protoHouseholdMember)
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *HouseholdMemberGrpc) CreateHouseholdMember(ctx context.Context, in *p.HouseholdMember) (*p.HouseholdMemberResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.HouseholdMemberResponse{Result: result}
+	hm, err := translateIn(in)
+	if err != nil {
+		return response, err
+	}
+	if err := a.domainHouseholdMember.Post(ctx, hm); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	var errTranslate error
+	response.HouseholdMember, errTranslate = translateOut(hm)
+	if errTranslate != nil {
+		return response, errTranslate
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *HouseholdMemberGrpc) UpdateHouseholdMember(ctx context.Context, in *p.HouseholdMember) (*p.Result, error) {
+	response := &p.Result{Success: false}
+	hm, err := translateIn(in)
+	if err != nil {
+		return response, err
+	}
+	if err := a.domainHouseholdMember.Patch(ctx, *hm); err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+	response.Success = true
+	return response, nil
+}
+
+func (a *HouseholdMemberGrpc) DeleteHouseholdMember(ctx context.Context, in *p.HouseholdMemberIDIn) (*p.Result, error) {
+	response := &p.Result{Success: false}
+	hm := &HouseholdMember{Id: int(in.Id)}
+	if err := a.domainHouseholdMember.Delete(ctx, hm); err != nil {
+		response.Error = err.Error()
+		return response, err
+	}
+	response.Success = true
+	return response, nil
+}
+
+func translateOut(hm *HouseholdMember) (*p.HouseholdMember, error) {
+	protoHouseholdMember := p.HouseholdMember{}
+	protoHouseholdMember.Id = int64(hm.Id)
+	protoHouseholdMember.HouseholdId = int64(hm.HouseholdId)
+	protoHouseholdMember.Name = hm.Name.String
+	protoHouseholdMember.Email = hm.Email.String
+	protoHouseholdMember.Phone = hm.Phone.String
+	return &protoHouseholdMember, nil
+}
+
+func translateIn(in *p.HouseholdMember) (*HouseholdMember, error) {
+	hm := HouseholdMember{}
+	hm.Id = int(in.Id)
+	hm.HouseholdId = int(in.HouseholdId)
+	hm.Name.Scan(in.Name)
+	hm.Email.Scan(in.Email)
+	hm.Phone.Scan(in.Phone)
+	return &hm, nil
+}
+
+// found these are slower; deprecated; keep them, just in case
+func translateJsonOut(hm *HouseholdMember) (*p.HouseholdMember, error) {
+	protoHouseholdMember := p.HouseholdMember{}
+	outBytes, err := json.Marshal(hm)
+	if err != nil {
+		return &protoHouseholdMember, ae.GeneralError("Unable to encode from HouseholdMember", err)
+	}
+	err = json.Unmarshal(outBytes, &protoHouseholdMember)
+	if err != nil {
+		return &protoHouseholdMember, ae.GeneralError("Unable to decode to proto.HouseholdMember", err)
+	}
+	return &protoHouseholdMember, nil
+}
+
+func translateJsonIn(in *p.HouseholdMember) (*HouseholdMember, error) {
+	hm := HouseholdMember{}
+	outBytes, err := json.Marshal(in)
+	if err != nil {
+		return &hm, ae.GeneralError("Unable to encode from proto.HouseholdMember", err)
+	}
+	err = json.Unmarshal(outBytes, &hm)
+	if err != nil {
+		return &hm, ae.GeneralError("Unable to decode to HouseholdMember", err)
+	}
+	return &hm, nil
+}
@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package householdmember is a generated GoMock package.
+package householdmember
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataHouseholdMemberV1Adapter is a mock of DataHouseholdMemberV1Adapter interface.
+type MockDataHouseholdMemberV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataHouseholdMemberV1AdapterMockRecorder
+}
+
+// MockDataHouseholdMemberV1AdapterMockRecorder is the mock recorder for MockDataHouseholdMemberV1Adapter.
+type MockDataHouseholdMemberV1AdapterMockRecorder struct {
+	mock *MockDataHouseholdMemberV1Adapter
+}
+
+// NewMockDataHouseholdMemberV1Adapter creates a new mock instance.
+func NewMockDataHouseholdMemberV1Adapter(ctrl *gomock.Controller) *MockDataHouseholdMemberV1Adapter {
+	mock := &MockDataHouseholdMemberV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataHouseholdMemberV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataHouseholdMemberV1Adapter) EXPECT() *MockDataHouseholdMemberV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDataHouseholdMemberV1Adapter) Create(arg0 context.Context, arg1 *HouseholdMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataHouseholdMemberV1AdapterMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataHouseholdMemberV1Adapter)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDataHouseholdMemberV1Adapter) Delete(arg0 context.Context, arg1 *HouseholdMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataHouseholdMemberV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataHouseholdMemberV1Adapter)(nil).Delete), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockDataHouseholdMemberV1Adapter) Read(arg0 context.Context, arg1 *HouseholdMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataHouseholdMemberV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataHouseholdMemberV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataHouseholdMemberV1Adapter) ReadAll(arg0 context.Context, arg1 *[]HouseholdMember, arg2 HouseholdMemberParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataHouseholdMemberV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataHouseholdMemberV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockDataHouseholdMemberV1Adapter) Update(arg0 context.Context, arg1 HouseholdMember) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataHouseholdMemberV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataHouseholdMemberV1Adapter)(nil).Update), arg0, arg1)
+}
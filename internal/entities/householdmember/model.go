@@ -0,0 +1,27 @@
+package householdmember
+
+import (
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	HouseholdMember struct {
+		Id          int         `db:"id" json:"id"`
+		HouseholdId int         `db:"household_id" json:"household_id"`
+		Name        null.String `db:"name" json:"name"`
+		Email       null.String `db:"email" json:"email"`
+		Phone       null.String `db:"phone" json:"phone"` // E.164
+	}
+
+	HouseholdMemberParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+)
+
+const HouseholdMemberConst = "household_member"
+
+func InitStorageV1() DataHouseholdMemberV1Adapter {
+	return InitSQLV1()
+}
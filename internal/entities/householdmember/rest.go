@@ -0,0 +1,157 @@
+package householdmember
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestHouseholdMemberV1 struct{}
+)
+
+var (
+	restV1   RestHouseholdMemberV1
+	domainV1 *DomainHouseholdMemberV1
+)
+
+func InitializeHouseholdMemberV1() *DomainHouseholdMemberV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainHouseholdMemberV1(storV1)
+	restV1 = *NewRestHouseholdMemberV1()
+	return domainV1
+}
+
+func RegisterHouseholdMember(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/household-member/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/household-member/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/household-member", Post)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/household-member", Patch)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/household-member/:id", Delete)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Post(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Post(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Patch(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Patch(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Delete(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Delete(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestHouseholdMemberV1() *RestHouseholdMemberV1 {
+	return &RestHouseholdMemberV1{}
+}
+
+func (h *RestHouseholdMemberV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	householdMember := &HouseholdMember{Id: int(id)}
+	if err := domainV1.Get(ctx, householdMember); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *householdMember, nil)
+}
+
+func (h *RestHouseholdMemberV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := HouseholdMemberParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	householdMembers := &[]HouseholdMember{}
+	totalCount, err := domainV1.Search(ctx, householdMembers, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *householdMembers, &totalCount)
+}
+
+func (h *RestHouseholdMemberV1) Post(c echo.Context) error {
+	ctx := context.Background()
+	hm := HouseholdMember{}
+	if err := c.Bind(&hm); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Post(ctx, &hm); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, hm, nil)
+}
+
+func (h *RestHouseholdMemberV1) Patch(c echo.Context) error {
+	ctx := context.Background()
+	hm := HouseholdMember{}
+	if err := c.Bind(&hm); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Patch(ctx, hm); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestHouseholdMemberV1) Delete(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	householdMember := &HouseholdMember{Id: int(id)}
+	if err := domainV1.Delete(ctx, householdMember); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
@@ -0,0 +1,125 @@
+package householdmember
+
+import (
+	"context"
+	"fmt"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLHouseholdMemberV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLHouseholdMemberV1 {
+	db := stor.InitStorage()
+	return &SQLHouseholdMemberV1{DB: db}
+}
+
+func (d *SQLHouseholdMemberV1) Read(ctx context.Context, hm *HouseholdMember) error {
+	sqlGet := `
+		SELECT
+			id,
+			household_id,
+			name,
+			email,
+			phone
+		FROM household_member WHERE id = $1`
+	if errDB := d.DB.Get(hm, sqlGet, hm.Id); errDB != nil {
+		return ae.DBError("HouseholdMember Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLHouseholdMemberV1) ReadAll(ctx context.Context, hm *[]HouseholdMember, param HouseholdMemberParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			household_id,
+			name,
+			email,
+			phone
+		FROM household_member
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(hm, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("HouseholdMember ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM household_member
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("household_member ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLHouseholdMemberV1) Create(ctx context.Context, hm *HouseholdMember) error {
+	count, errCount := d.count()
+	if errCount != nil {
+		return errCount
+	}
+	hm.Id = count
+	sqlPost := `
+		INSERT INTO household_member (
+			id,
+			household_id,
+			name,
+			email,
+			phone
+		) VALUES (
+			:id,
+			:household_id,
+			:name,
+			:email,
+			:phone
+		)`
+	_, errDB := d.DB.NamedExec(sqlPost, hm)
+	if errDB != nil {
+		return ae.DBError("HouseholdMember Post: unable to insert record.", errDB)
+	}
+
+	return nil
+}
+
+func (d *SQLHouseholdMemberV1) Update(ctx context.Context, hm HouseholdMember) error {
+	sqlPatch := `
+		UPDATE household_member SET
+			household_id = :household_id,
+			name = :name,
+			email = :email,
+			phone = :phone
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, hm); errDB != nil {
+		return ae.DBError("HouseholdMember Patch: unable to update record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLHouseholdMemberV1) Delete(ctx context.Context, hm *HouseholdMember) error {
+	sqlDelete := `
+		DELETE FROM household_member WHERE id = $1`
+	if _, errDB := d.DB.Exec(sqlDelete, hm.Id); errDB != nil {
+		return ae.DBError("HouseholdMember Delete: unable to delete record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLHouseholdMemberV1) count() (int, error) {
+	count := 0
+	if errDB := d.DB.Get(&count, "SELECT COALESCE(MAX(id), 0) FROM household_member"); errDB != nil {
+		return 0, ae.DBError("HouseholdMember count: unable to get count.", errDB)
+	}
+	return count + 1, nil
+}
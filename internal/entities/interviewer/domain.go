@@ -0,0 +1,135 @@
+package interviewer
+
+import (
+	"context"
+	"net/mail"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"gopkg.in/guregu/null.v3"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=interviewer
+type (
+	DataInterviewerV1Adapter interface {
+		Read(context.Context, *Interviewer) error
+		ReadAll(context.Context, *[]Interviewer, InterviewerParam) (int, error)
+		Create(context.Context, *Interviewer) error
+		Update(context.Context, Interviewer) error
+		Delete(context.Context, *Interviewer) error
+	}
+
+	DomainInterviewerV1 struct {
+		dataInterviewerV1 DataInterviewerV1Adapter
+		auditWriter       a.AuditAdapter
+	}
+)
+
+func NewDomainInterviewerV1(citvV1 DataInterviewerV1Adapter) *DomainInterviewerV1 {
+	aw := a.AuditInit()
+	return &DomainInterviewerV1{dataInterviewerV1: citvV1, auditWriter: aw}
+}
+
+func (m *DomainInterviewerV1) Get(ctx context.Context, itv *Interviewer) error {
+	if itv.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	return m.dataInterviewerV1.Read(ctx, itv)
+}
+
+func (m *DomainInterviewerV1) Search(ctx context.Context, itv *[]Interviewer, param InterviewerParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("name", map[string]string{"id": "id", "name": "name", "title": "title", "email": "email", "active": "active"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataInterviewerV1.ReadAll(ctx, itv, param)
+}
+
+func (m *DomainInterviewerV1) Post(ctx context.Context, itv *Interviewer) error {
+	if !itv.Name.Valid {
+		return ae.MissingParamError("Name")
+	}
+	if itv.Name.Valid && len(itv.Name.ValueOrZero()) > 100 {
+		return ae.StringLengthError("Name", 100)
+	}
+	if itv.Title.Valid && len(itv.Title.ValueOrZero()) > 100 {
+		return ae.StringLengthError("Title", 100)
+	}
+	if itv.Email.Valid && len(itv.Email.ValueOrZero()) > 255 {
+		return ae.StringLengthError("Email", 255)
+	}
+	if itv.Email.Valid && itv.Email.String != "" {
+		if _, err := mail.ParseAddress(itv.Email.String); err != nil {
+			return ae.EmailValidError(err.Error())
+		}
+	}
+	if !itv.Active.Valid {
+		itv.Active = null.BoolFrom(true)
+	}
+	if err := m.dataInterviewerV1.Create(ctx, itv); err != nil {
+		return err
+	}
+	go a.AuditCreate(m.auditWriter, *itv, InterviewerConst, a.KeysToString("id", itv.Id))
+	return nil
+}
+
+func (m *DomainInterviewerV1) Patch(ctx context.Context, itvIn Interviewer) error {
+	itv := &Interviewer{Id: itvIn.Id}
+	errGet := m.dataInterviewerV1.Read(ctx, itv)
+	if errGet != nil {
+		return errGet
+	}
+	existingValues := make(map[string]any)
+	// Name
+	if itvIn.Name.Valid {
+		if itvIn.Name.Valid && len(itvIn.Name.ValueOrZero()) > 100 {
+			return ae.StringLengthError("Name", 100)
+		}
+		existingValues["name"] = itv.Name.String
+		itv.Name = itvIn.Name
+	}
+	// Title
+	if itvIn.Title.Valid {
+		if itvIn.Title.Valid && len(itvIn.Title.ValueOrZero()) > 100 {
+			return ae.StringLengthError("Title", 100)
+		}
+		existingValues["title"] = itv.Title.String
+		itv.Title = itvIn.Title
+	}
+	// Email
+	if itvIn.Email.Valid {
+		if itvIn.Email.Valid && len(itvIn.Email.ValueOrZero()) > 255 {
+			return ae.StringLengthError("Email", 255)
+		}
+		if itvIn.Email.String != "" {
+			if _, err := mail.ParseAddress(itvIn.Email.String); err != nil {
+				return ae.EmailValidError(err.Error())
+			}
+		}
+		existingValues["email"] = itv.Email.String
+		itv.Email = itvIn.Email
+	}
+	// Active
+	if itvIn.Active.Valid {
+		existingValues["active"] = itv.Active.Bool
+		itv.Active = itvIn.Active
+	}
+	if err := m.dataInterviewerV1.Update(ctx, *itv); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *itv, InterviewerConst, a.KeysToString("id", itv.Id), existingValues)
+	return nil
+}
+
+func (m *DomainInterviewerV1) Delete(ctx context.Context, itv *Interviewer) error {
+	if itv.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataInterviewerV1.Delete(ctx, itv); err != nil {
+		return err
+	}
+	go a.AuditDelete(m.auditWriter, *itv, InterviewerConst, a.KeysToString("id", itv.Id))
+	return nil
+}
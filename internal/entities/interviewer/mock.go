@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package interviewer is a generated GoMock package.
+package interviewer
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataInterviewerV1Adapter is a mock of DataInterviewerV1Adapter interface.
+type MockDataInterviewerV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataInterviewerV1AdapterMockRecorder
+}
+
+// MockDataInterviewerV1AdapterMockRecorder is the mock recorder for MockDataInterviewerV1Adapter.
+type MockDataInterviewerV1AdapterMockRecorder struct {
+	mock *MockDataInterviewerV1Adapter
+}
+
+// NewMockDataInterviewerV1Adapter creates a new mock instance.
+func NewMockDataInterviewerV1Adapter(ctrl *gomock.Controller) *MockDataInterviewerV1Adapter {
+	mock := &MockDataInterviewerV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataInterviewerV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataInterviewerV1Adapter) EXPECT() *MockDataInterviewerV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDataInterviewerV1Adapter) Create(arg0 context.Context, arg1 *Interviewer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataInterviewerV1AdapterMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataInterviewerV1Adapter)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDataInterviewerV1Adapter) Delete(arg0 context.Context, arg1 *Interviewer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataInterviewerV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataInterviewerV1Adapter)(nil).Delete), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockDataInterviewerV1Adapter) Read(arg0 context.Context, arg1 *Interviewer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataInterviewerV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataInterviewerV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataInterviewerV1Adapter) ReadAll(arg0 context.Context, arg1 *[]Interviewer, arg2 InterviewerParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataInterviewerV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataInterviewerV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockDataInterviewerV1Adapter) Update(arg0 context.Context, arg1 Interviewer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataInterviewerV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataInterviewerV1Adapter)(nil).Update), arg0, arg1)
+}
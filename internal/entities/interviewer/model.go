@@ -0,0 +1,27 @@
+package interviewer
+
+import (
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	Interviewer struct {
+		Id     int         `db:"id" json:"id"`
+		Name   null.String `db:"name" json:"name"`
+		Title  null.String `db:"title" json:"title"`
+		Email  null.String `db:"email" json:"email"`
+		Active null.Bool   `db:"active" json:"active"`
+	}
+
+	InterviewerParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+)
+
+const InterviewerConst = "interviewer"
+
+func InitStorageV1() DataInterviewerV1Adapter {
+	return InitSQLV1()
+}
@@ -0,0 +1,157 @@
+package interviewer
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestInterviewerV1 struct{}
+)
+
+var (
+	restV1   RestInterviewerV1
+	domainV1 *DomainInterviewerV1
+)
+
+func InitializeInterviewerV1() *DomainInterviewerV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainInterviewerV1(storV1)
+	restV1 = *NewRestInterviewerV1()
+	return domainV1
+}
+
+func RegisterInterviewer(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/interviewer/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/interviewer/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/interviewer", Post)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/interviewer", Patch)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/interviewer/:id", Delete)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Post(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Post(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Patch(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Patch(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Delete(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Delete(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestInterviewerV1() *RestInterviewerV1 {
+	return &RestInterviewerV1{}
+}
+
+func (h *RestInterviewerV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	interviewer := &Interviewer{Id: int(id)}
+	if err := domainV1.Get(ctx, interviewer); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *interviewer, nil)
+}
+
+func (h *RestInterviewerV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := InterviewerParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	interviewers := &[]Interviewer{}
+	totalCount, err := domainV1.Search(ctx, interviewers, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *interviewers, &totalCount)
+}
+
+func (h *RestInterviewerV1) Post(c echo.Context) error {
+	ctx := context.Background()
+	itv := Interviewer{}
+	if err := c.Bind(&itv); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Post(ctx, &itv); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, itv, nil)
+}
+
+func (h *RestInterviewerV1) Patch(c echo.Context) error {
+	ctx := context.Background()
+	itv := Interviewer{}
+	if err := c.Bind(&itv); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Patch(ctx, itv); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestInterviewerV1) Delete(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	interviewer := &Interviewer{Id: int(id)}
+	if err := domainV1.Delete(ctx, interviewer); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
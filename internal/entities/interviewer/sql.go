@@ -0,0 +1,125 @@
+package interviewer
+
+import (
+	"context"
+	"fmt"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLInterviewerV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLInterviewerV1 {
+	db := stor.InitStorage()
+	return &SQLInterviewerV1{DB: db}
+}
+
+func (d *SQLInterviewerV1) Read(ctx context.Context, itv *Interviewer) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			title,
+			email,
+			active
+		FROM interviewer WHERE id = $1`
+	if errDB := d.DB.Get(itv, sqlGet, itv.Id); errDB != nil {
+		return ae.DBError("Interviewer Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLInterviewerV1) ReadAll(ctx context.Context, itv *[]Interviewer, param InterviewerParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			title,
+			email,
+			active
+		FROM interviewer
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(itv, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("Interviewer ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM interviewer
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("interviewer ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLInterviewerV1) Create(ctx context.Context, itv *Interviewer) error {
+	count, errCount := d.count()
+	if errCount != nil {
+		return errCount
+	}
+	itv.Id = count
+	sqlPost := `
+		INSERT INTO interviewer (
+			id,
+			name,
+			title,
+			email,
+			active
+		) VALUES (
+			:id,
+			:name,
+			:title,
+			:email,
+			:active
+		)`
+	_, errDB := d.DB.NamedExec(sqlPost, itv)
+	if errDB != nil {
+		return ae.DBError("Interviewer Post: unable to insert record.", errDB)
+	}
+
+	return nil
+}
+
+func (d *SQLInterviewerV1) Update(ctx context.Context, itv Interviewer) error {
+	sqlPatch := `
+		UPDATE interviewer SET
+			name = :name,
+			title = :title,
+			email = :email,
+			active = :active
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, itv); errDB != nil {
+		return ae.DBError("Interviewer Patch: unable to update record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLInterviewerV1) Delete(ctx context.Context, itv *Interviewer) error {
+	sqlDelete := `
+		DELETE FROM interviewer WHERE id = $1`
+	if _, errDB := d.DB.Exec(sqlDelete, itv.Id); errDB != nil {
+		return ae.DBError("Interviewer Delete: unable to delete record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLInterviewerV1) count() (int, error) {
+	count := 0
+	if errDB := d.DB.Get(&count, "SELECT COALESCE(MAX(id), 0) FROM interviewer"); errDB != nil {
+		return 0, ae.DBError("Interviewer count: unable to get count.", errDB)
+	}
+	return count + 1, nil
+}
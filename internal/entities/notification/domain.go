@@ -0,0 +1,174 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/sms"
+	"gopkg.in/guregu/null.v3"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=notification
+type (
+	DataNotificationV1Adapter interface {
+		Read(context.Context, *Notification) error
+		ReadAll(context.Context, *[]Notification, NotificationParam) (int, error)
+		Enqueue(context.Context, *Notification) (bool, error)
+		ReadDue(context.Context, *[]Notification, time.Time, int) error
+		Update(context.Context, Notification) error
+	}
+
+	DomainNotificationV1 struct {
+		dataNotificationV1 DataNotificationV1Adapter
+		dataTdDateV1       tddate.DataTdDateV1Adapter
+		emailer            email.Emailer
+		sms                sms.Notifier
+	}
+)
+
+func NewDomainNotificationV1(cnotV1 DataNotificationV1Adapter) *DomainNotificationV1 {
+	em := email.EmailInit()
+	sn := sms.SMSInit()
+	ctdV1 := tddate.InitStorageV1()
+	return &DomainNotificationV1{dataNotificationV1: cnotV1, dataTdDateV1: ctdV1, emailer: em, sms: sn}
+}
+
+func (m *DomainNotificationV1) Get(ctx context.Context, not *Notification) error {
+	if not.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	return m.dataNotificationV1.Read(ctx, not)
+}
+
+func (m *DomainNotificationV1) Search(ctx context.Context, not *[]Notification, param NotificationParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("-created_at", map[string]string{"id": "id", "recipient": "recipient", "td_date_id": "td_date_id", "kind": "kind", "dedupe_key": "dedupe_key", "status": "status", "attempts": "attempts", "last_error": "last_error", "next_attempt": "next_attempt", "created_at": "created_at", "sent_at": "sent_at"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataNotificationV1.ReadAll(ctx, not, param)
+}
+
+// Enqueue adds the message to the outbox to be sent by Deliver, false means it was already queued (or sent), a cancelled one is queued again
+// the dedupe key defaults to kind:td_date_id:recipient, one message per booking per kind
+func (m *DomainNotificationV1) Enqueue(ctx context.Context, not *Notification) (bool, error) {
+	if !not.Recipient.Valid || not.Recipient.String == "" {
+		return false, ae.MissingParamError("Recipient")
+	}
+	if !not.Kind.Valid || not.Kind.String == "" {
+		return false, ae.MissingParamError("Kind")
+	}
+	if !not.DedupeKey.Valid {
+		not.DedupeKey = null.StringFrom(fmt.Sprintf("%s:%d:%s", not.Kind.String, not.TdDateId.Int64, not.Recipient.String))
+	}
+	now := time.Now().UTC()
+	not.Status = null.StringFrom(StatusPending)
+	not.Attempts = 0
+	not.LastError = null.String{}
+	not.NextAttempt = null.TimeFrom(now)
+	not.CreatedAt = null.TimeFrom(now)
+	not.SentAt = null.Time{}
+	return m.dataNotificationV1.Enqueue(ctx, not)
+}
+
+// Deliver sends the pending messages that are due, a failed send is retried with backoff until maxAttempts
+// a message for a booking that was cancelled, moved or changed hands since it was queued is cancelled instead
+// it is run by the scheduler, which keeps two deliveries from overlapping
+func (m *DomainNotificationV1) Deliver(ctx context.Context) error {
+	now := time.Now().UTC()
+	due := []Notification{}
+	if err := m.dataNotificationV1.ReadDue(ctx, &due, now, deliverBatch); err != nil {
+		return err
+	}
+	for _, not := range due {
+		stale, errStale := m.stale(ctx, not)
+		if errStale == nil && stale {
+			not.Status = null.StringFrom(StatusCancelled)
+			not.NextAttempt = null.Time{}
+			if err := m.dataNotificationV1.Update(ctx, not); err != nil {
+				logging.Default.Println("Error saving notification id", not.Id, ":", err)
+			}
+			continue
+		}
+		not.Attempts++
+		errSend := errStale
+		if errSend == nil {
+			errSend = m.send(ctx, not)
+		}
+		if errSend != nil {
+			not.LastError = null.StringFrom(errSend.Error())
+			not.NextAttempt = null.TimeFrom(time.Now().UTC().Add(backoff(not.Attempts)))
+			if not.Attempts >= maxAttempts {
+				not.Status = null.StringFrom(StatusFailed)
+				not.NextAttempt = null.Time{}
+			}
+		} else {
+			not.Status = null.StringFrom(StatusSent)
+			not.SentAt = null.TimeFrom(time.Now().UTC())
+			not.LastError = null.String{}
+			not.NextAttempt = null.Time{}
+		}
+		if err := m.dataNotificationV1.Update(ctx, not); err != nil {
+			// the message may go out again, better than losing track of it
+			logging.Default.Println("Error saving notification id", not.Id, ":", err)
+		}
+	}
+	return nil
+}
+
+// stale is whether the booking the message is about is no longer confirmed for its recipient
+func (m *DomainNotificationV1) stale(ctx context.Context, not Notification) (bool, error) {
+	if !not.TdDateId.Valid {
+		return false, nil
+	}
+	param := tddate.TdDateParam{Param: h.Param{Search: h.Search{Filters: []h.Filter{{Column: "id", Compare: "=", Value: not.TdDateId.Int64}}}}}
+	param.Param.CalculateParam("id", map[string]string{"id": "id"})
+	tdDates := []tddate.TdDate{}
+	if _, err := m.dataTdDateV1.ReadAll(ctx, &tdDates, param); err != nil {
+		return false, err
+	}
+	if len(tdDates) == 0 || !tdDates[0].Confirm.Valid {
+		return true, nil
+	}
+	recipient := tdDates[0].Email.String
+	if not.Kind.String == KindSmsReminder {
+		recipient = tdDates[0].Phone.String
+	}
+	return !strings.EqualFold(recipient, not.Recipient.String), nil
+}
+
+func (m *DomainNotificationV1) send(ctx context.Context, not Notification) error {
+	to := []string{not.Recipient.String}
+	switch not.Kind.String {
+	case KindIndividualReminder:
+		var calendar []byte
+		if not.Calendar.Valid {
+			calendar = []byte(not.Calendar.String)
+		}
+		return m.emailer.SendIndividualReminder(ctx, to, not.Body.String, calendar)
+	case KindReminderDigest:
+		return m.emailer.SendReminder(ctx, to, not.Body.String)
+	case KindSmsReminder:
+		return m.sms.SendReminder(ctx, not.Recipient.String, not.Body.String)
+	case KindNoShowFollowUp:
+		return m.emailer.SendNoShowFollowUp(ctx, to, not.Body.String)
+	}
+	return fmt.Errorf("unknown notification kind: %s", not.Kind.String)
+}
+
+// backoff doubles the wait after each attempt: 1m, 2m, 4m... up to backoffMax
+func backoff(attempts int) time.Duration {
+	wait := backoffBase
+	for i := 1; i < attempts && wait < backoffMax; i++ {
+		wait *= 2
+	}
+	return min(wait, backoffMax)
+}
@@ -0,0 +1,189 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/sms"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDomainNotificationV1_Enqueue(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataNotification := NewMockDataNotificationV1Adapter(ctrl)
+
+	tests := []struct {
+		name          string
+		not           *Notification
+		wantErr       bool
+		wantDedupeKey string
+		calls         []*gomock.Call
+	}{
+		{
+			"successful - default dedupe key",
+			&Notification{Recipient: null.StringFrom("smith@example.com"), TdDateId: null.IntFrom(4), Kind: null.StringFrom(KindIndividualReminder)},
+			false,
+			"individual-reminder:4:smith@example.com",
+			[]*gomock.Call{mockDataNotification.EXPECT().Enqueue(ctx, gomock.Any()).Return(true, nil)},
+		},
+		{
+			"successful - given dedupe key",
+			&Notification{Recipient: null.StringFrom("bishop@example.com"), Kind: null.StringFrom(KindReminderDigest), DedupeKey: null.StringFrom("reminder-digest:2025-12-05:bishop@example.com")},
+			false,
+			"reminder-digest:2025-12-05:bishop@example.com",
+			[]*gomock.Call{mockDataNotification.EXPECT().Enqueue(ctx, gomock.Any()).Return(true, nil)},
+		},
+		{
+			"failed - recipient",
+			&Notification{Kind: null.StringFrom(KindIndividualReminder)},
+			true,
+			"",
+			[]*gomock.Call{},
+		},
+		{
+			"failed - kind",
+			&Notification{Recipient: null.StringFrom("smith@example.com")},
+			true,
+			"",
+			[]*gomock.Call{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DomainNotificationV1{dataNotificationV1: mockDataNotification}
+			_, err := m.Enqueue(ctx, tt.not)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainNotificationV1.Enqueue().%s => expected error: got: %s", tt.name, err)
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantDedupeKey, tt.not.DedupeKey.String, "DomainNotificationV1.Enqueue().%s => unexpected dedupe key", tt.name)
+				assert.Equal(t, StatusPending, tt.not.Status.String, "DomainNotificationV1.Enqueue().%s => unexpected status", tt.name)
+			}
+		})
+	}
+}
+
+func TestDomainNotificationV1_Deliver(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataNotification := NewMockDataNotificationV1Adapter(ctrl)
+	mockEmailer := email.NewMockEmailer(ctrl)
+
+	pending := func(attempts int) Notification {
+		return Notification{Id: 1, Recipient: null.StringFrom("smith@example.com"), Kind: null.StringFrom(KindIndividualReminder), Body: null.StringFrom("reminder"), Status: null.StringFrom(StatusPending), Attempts: attempts}
+	}
+
+	tests := []struct {
+		name         string
+		due          Notification
+		sendErr      error
+		wantStatus   string
+		wantAttempts int
+		wantRetry    bool
+	}{
+		{"successful", pending(0), nil, StatusSent, 1, false},
+		{"failed - retried", pending(1), errors.New("smtp down"), StatusPending, 2, true},
+		{"failed - gave up", pending(maxAttempts - 1), errors.New("smtp down"), StatusFailed, maxAttempts, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockDataNotification.EXPECT().ReadDue(ctx, gomock.Any(), gomock.Any(), deliverBatch).DoAndReturn(func(_ context.Context, not *[]Notification, _ time.Time, _ int) error {
+				*not = []Notification{tt.due}
+				return nil
+			})
+			mockEmailer.EXPECT().SendIndividualReminder(ctx, []string{"smith@example.com"}, "reminder", gomock.Any()).Return(tt.sendErr)
+			mockDataNotification.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, not Notification) error {
+				assert.Equal(t, tt.wantStatus, not.Status.String, "DomainNotificationV1.Deliver().%s => unexpected status", tt.name)
+				assert.Equal(t, tt.wantAttempts, not.Attempts, "DomainNotificationV1.Deliver().%s => unexpected attempts", tt.name)
+				assert.Equal(t, tt.wantRetry, not.NextAttempt.Valid, "DomainNotificationV1.Deliver().%s => unexpected next attempt", tt.name)
+				assert.Equal(t, tt.sendErr != nil, not.LastError.Valid, "DomainNotificationV1.Deliver().%s => unexpected last error", tt.name)
+				return nil
+			})
+			m := &DomainNotificationV1{dataNotificationV1: mockDataNotification, emailer: mockEmailer}
+			err := m.Deliver(ctx)
+			assert.Nil(t, err, "DomainNotificationV1.Deliver().%s => expected not error; got: %s", tt.name, err)
+		})
+	}
+}
+
+func TestDomainNotificationV1_DeliverSms(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataNotification := NewMockDataNotificationV1Adapter(ctrl)
+	mockSms := sms.NewMockNotifier(ctrl)
+
+	mockDataNotification.EXPECT().ReadDue(ctx, gomock.Any(), gomock.Any(), deliverBatch).DoAndReturn(func(_ context.Context, not *[]Notification, _ time.Time, _ int) error {
+		*not = []Notification{{Id: 1, Recipient: null.StringFrom("+18015550123"), Kind: null.StringFrom(KindSmsReminder), Body: null.StringFrom("Sunday, December 7, 2025, 09:00 AM - 09:20 AM"), Status: null.StringFrom(StatusPending)}}
+		return nil
+	})
+	mockSms.EXPECT().SendReminder(ctx, "+18015550123", "Sunday, December 7, 2025, 09:00 AM - 09:20 AM").Return(nil)
+	mockDataNotification.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, not Notification) error {
+		assert.Equal(t, StatusSent, not.Status.String, "DomainNotificationV1.Deliver() => unexpected status")
+		return nil
+	})
+	m := &DomainNotificationV1{dataNotificationV1: mockDataNotification, sms: mockSms}
+	err := m.Deliver(ctx)
+	assert.Nil(t, err, "DomainNotificationV1.Deliver() => expected not error; got: %s", err)
+}
+
+func TestDomainNotificationV1_DeliverStale(t *testing.T) {
+	ctx := context.TODO()
+	due := Notification{Id: 1, Recipient: null.StringFrom("smith@example.com"), TdDateId: null.IntFrom(4), Kind: null.StringFrom(KindIndividualReminder), Body: null.StringFrom("reminder"), Status: null.StringFrom(StatusPending)}
+	booked := tddate.TdDate{Id: 4, Confirm: null.TimeFrom(time.Now().UTC()), Email: null.StringFrom("Smith@Example.com")}
+
+	tests := []struct {
+		name       string
+		tdDates    []tddate.TdDate
+		wantStatus string
+	}{
+		{"still booked", []tddate.TdDate{booked}, StatusSent},
+		{"cancelled", []tddate.TdDate{{Id: 4}}, StatusCancelled},
+		{"rebooked by someone else", []tddate.TdDate{func() tddate.TdDate { b := booked; b.Email = null.StringFrom("jones@example.com"); return b }()}, StatusCancelled},
+		{"slot deleted", []tddate.TdDate{}, StatusCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataNotification := NewMockDataNotificationV1Adapter(ctrl)
+			mockDataTdDate := tddate.NewMockDataTdDateV1Adapter(ctrl)
+			mockEmailer := email.NewMockEmailer(ctrl)
+			mockDataNotification.EXPECT().ReadDue(ctx, gomock.Any(), gomock.Any(), deliverBatch).SetArg(1, []Notification{due}).Return(nil)
+			mockDataTdDate.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tdDates *[]tddate.TdDate, param tddate.TdDateParam) (int, error) {
+				assert.Equal(t, int64(4), param.Search.Filters[0].Value)
+				*tdDates = tt.tdDates
+				return len(tt.tdDates), nil
+			})
+			if tt.wantStatus == StatusSent {
+				mockEmailer.EXPECT().SendIndividualReminder(ctx, []string{"smith@example.com"}, "reminder", gomock.Any()).Return(nil)
+			}
+			mockDataNotification.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, not Notification) error {
+				assert.Equal(t, tt.wantStatus, not.Status.String, "DomainNotificationV1.Deliver().%s => unexpected status", tt.name)
+				assert.False(t, not.NextAttempt.Valid, "DomainNotificationV1.Deliver().%s => unexpected next attempt", tt.name)
+				return nil
+			})
+			m := &DomainNotificationV1{dataNotificationV1: mockDataNotification, dataTdDateV1: mockDataTdDate, emailer: mockEmailer}
+			err := m.Deliver(ctx)
+			assert.Nil(t, err, "DomainNotificationV1.Deliver().%s => expected not error; got: %s", tt.name, err)
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{4, 8 * time.Minute},
+		{20, time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, backoff(tt.attempts), "backoff(%d) => unexpected wait", tt.attempts)
+	}
+}
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package notification is a generated GoMock package.
+package notification
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataNotificationV1Adapter is a mock of DataNotificationV1Adapter interface.
+type MockDataNotificationV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataNotificationV1AdapterMockRecorder
+}
+
+// MockDataNotificationV1AdapterMockRecorder is the mock recorder for MockDataNotificationV1Adapter.
+type MockDataNotificationV1AdapterMockRecorder struct {
+	mock *MockDataNotificationV1Adapter
+}
+
+// NewMockDataNotificationV1Adapter creates a new mock instance.
+func NewMockDataNotificationV1Adapter(ctrl *gomock.Controller) *MockDataNotificationV1Adapter {
+	mock := &MockDataNotificationV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataNotificationV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataNotificationV1Adapter) EXPECT() *MockDataNotificationV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockDataNotificationV1Adapter) Enqueue(arg0 context.Context, arg1 *Notification) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockDataNotificationV1AdapterMockRecorder) Enqueue(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockDataNotificationV1Adapter)(nil).Enqueue), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockDataNotificationV1Adapter) Read(arg0 context.Context, arg1 *Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataNotificationV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataNotificationV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataNotificationV1Adapter) ReadAll(arg0 context.Context, arg1 *[]Notification, arg2 NotificationParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataNotificationV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataNotificationV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// ReadDue mocks base method.
+func (m *MockDataNotificationV1Adapter) ReadDue(arg0 context.Context, arg1 *[]Notification, arg2 time.Time, arg3 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDue", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadDue indicates an expected call of ReadDue.
+func (mr *MockDataNotificationV1AdapterMockRecorder) ReadDue(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDue", reflect.TypeOf((*MockDataNotificationV1Adapter)(nil).ReadDue), arg0, arg1, arg2, arg3)
+}
+
+// Update mocks base method.
+func (m *MockDataNotificationV1Adapter) Update(arg0 context.Context, arg1 Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataNotificationV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataNotificationV1Adapter)(nil).Update), arg0, arg1)
+}
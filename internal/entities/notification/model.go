@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"time"
+
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	// Notification is one message to one recipient in the outbox, the dedupe key makes queuing the same message twice a no-op
+	Notification struct {
+		Id          int         `db:"id" json:"id"`
+		Recipient   null.String `db:"recipient" json:"recipient"`
+		TdDateId    null.Int    `db:"td_date_id" json:"td_date_id"`
+		Kind        null.String `db:"kind" json:"kind"`
+		DedupeKey   null.String `db:"dedupe_key" json:"dedupe_key"`
+		Body        null.String `db:"body" json:"body"`
+		Calendar    null.String `db:"calendar" json:"-"` // ics attached to the message, if any
+		Status      null.String `db:"status" json:"status"`
+		Attempts    int         `db:"attempts" json:"attempts"`
+		LastError   null.String `db:"last_error" json:"last_error"`
+		NextAttempt null.Time   `db:"next_attempt" json:"next_attempt"`
+		CreatedAt   null.Time   `db:"created_at" json:"created_at"`
+		SentAt      null.Time   `db:"sent_at" json:"sent_at"`
+	}
+
+	NotificationParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+)
+
+const (
+	NotificationConst = "notification"
+
+	// kinds
+	KindIndividualReminder = "individual-reminder"
+	KindReminderDigest     = "reminder-digest"
+	KindSmsReminder        = "sms-reminder"      // recipient is the E.164 phone, body is the appointment
+	KindNoShowFollowUp     = "no-show-follow-up" // body is the missed appointment
+
+	// statuses
+	StatusPending   = "pending"
+	StatusSent      = "sent"
+	StatusFailed    = "failed"    // gave up after maxAttempts
+	StatusCancelled = "cancelled" // the booking was cancelled, moved or changed hands before it went out
+
+	maxAttempts  = 5
+	backoffBase  = time.Minute
+	backoffMax   = time.Hour
+	deliverBatch = 50
+)
+
+func InitStorageV1() DataNotificationV1Adapter {
+	return InitSQLV1()
+}
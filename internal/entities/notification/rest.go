@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestNotificationV1 struct{}
+)
+
+var (
+	restV1   RestNotificationV1
+	domainV1 *DomainNotificationV1
+)
+
+func InitializeNotificationV1() *DomainNotificationV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainNotificationV1(storV1)
+	restV1 = *NewRestNotificationV1()
+	return domainV1
+}
+
+// the outbox is read only, messages are added by the code that sends them
+func RegisterNotification(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/notification/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/notification/search", Search)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestNotificationV1() *RestNotificationV1 {
+	return &RestNotificationV1{}
+}
+
+func (h *RestNotificationV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	notification := &Notification{Id: int(id)}
+	if err := domainV1.Get(ctx, notification); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *notification, nil)
+}
+
+func (h *RestNotificationV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := NotificationParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	notifications := &[]Notification{}
+	totalCount, err := domainV1.Search(ctx, notifications, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *notifications, &totalCount)
+}
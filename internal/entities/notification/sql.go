@@ -0,0 +1,173 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLNotificationV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLNotificationV1 {
+	db := stor.InitStorage()
+	return &SQLNotificationV1{DB: db}
+}
+
+func (d *SQLNotificationV1) Read(ctx context.Context, not *Notification) error {
+	sqlGet := `
+		SELECT
+			id,
+			recipient,
+			td_date_id,
+			kind,
+			dedupe_key,
+			body,
+			calendar,
+			status,
+			attempts,
+			last_error,
+			next_attempt,
+			created_at,
+			sent_at
+		FROM notification WHERE id = $1`
+	if errDB := d.DB.Get(not, sqlGet, not.Id); errDB != nil {
+		return ae.DBError("Notification Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLNotificationV1) ReadAll(ctx context.Context, not *[]Notification, param NotificationParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			recipient,
+			td_date_id,
+			kind,
+			dedupe_key,
+			body,
+			calendar,
+			status,
+			attempts,
+			last_error,
+			next_attempt,
+			created_at,
+			sent_at
+		FROM notification
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(not, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("Notification ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM notification
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("notification ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+// inserts the message unless one with the same dedupe_key exists, false if it already existed
+func (d *SQLNotificationV1) Enqueue(ctx context.Context, not *Notification) (bool, error) {
+	sqlEnqueue := `
+		INSERT INTO notification (
+			id,
+			recipient,
+			td_date_id,
+			kind,
+			dedupe_key,
+			body,
+			calendar,
+			status,
+			attempts,
+			last_error,
+			next_attempt,
+			created_at,
+			sent_at
+		) VALUES (
+			(SELECT COALESCE(MAX(id), 0) + 1 FROM notification),
+			:recipient,
+			:td_date_id,
+			:kind,
+			:dedupe_key,
+			:body,
+			:calendar,
+			:status,
+			:attempts,
+			:last_error,
+			:next_attempt,
+			:created_at,
+			:sent_at
+		) ON CONFLICT (dedupe_key) DO UPDATE SET
+			recipient = excluded.recipient,
+			body = excluded.body,
+			calendar = excluded.calendar,
+			status = excluded.status,
+			attempts = excluded.attempts,
+			last_error = excluded.last_error,
+			next_attempt = excluded.next_attempt,
+			created_at = excluded.created_at
+		WHERE notification.status = '` + StatusCancelled + `'`
+	result, errDB := d.DB.NamedExec(sqlEnqueue, not)
+	if errDB != nil {
+		return false, ae.DBError("Notification Enqueue: unable to insert record.", errDB)
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
+
+func (d *SQLNotificationV1) ReadDue(ctx context.Context, not *[]Notification, now time.Time, limit int) error {
+	sqlDue := `
+		SELECT
+			id,
+			recipient,
+			td_date_id,
+			kind,
+			dedupe_key,
+			body,
+			calendar,
+			status,
+			attempts,
+			last_error,
+			next_attempt,
+			created_at,
+			sent_at
+		FROM notification
+		WHERE status = $1 AND next_attempt <= $2
+		ORDER BY next_attempt, id
+		LIMIT $3`
+	if errDB := d.DB.Select(not, sqlDue, StatusPending, now, limit); errDB != nil {
+		return ae.DBError("Notification ReadDue: unable to select records.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLNotificationV1) Update(ctx context.Context, not Notification) error {
+	sqlPatch := `
+		UPDATE notification SET
+			status = :status,
+			attempts = :attempts,
+			last_error = :last_error,
+			next_attempt = :next_attempt,
+			sent_at = :sent_at
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, not); errDB != nil {
+		return ae.DBError("Notification Patch: unable to update record.", errDB)
+	}
+	return nil
+}
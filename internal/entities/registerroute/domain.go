@@ -40,6 +40,10 @@ var InsecureRoutes = []string{
 	"POST/login/sign-in",
 	"POST/login/oauth2/authorize",
 	"POST/login/oauth2/verify-consent",
+	"GET/td-date/manage/:token",
+	"DELETE/td-date/manage/:token",
+	"PATCH/td-date/manage/:token",
+	"POST/waitlist/claim/:token",
 }
 
 func NewDomainRegisterRouteV1(cregV1 DataRegisterRouteV1Adapter) *DomainRegisterRouteV1 {
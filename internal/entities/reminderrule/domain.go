@@ -0,0 +1,197 @@
+package reminderrule
+
+import (
+	"bytes"
+	"context"
+	"text/template"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/cron"
+	"gopkg.in/guregu/null.v3"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=reminderrule
+type (
+	DataReminderRuleV1Adapter interface {
+		Read(context.Context, *ReminderRule) error
+		ReadAll(context.Context, *[]ReminderRule, ReminderRuleParam) (int, error)
+		Create(context.Context, *ReminderRule) error
+		Update(context.Context, ReminderRule) error
+		Delete(context.Context, *ReminderRule) error
+		SetLastRun(context.Context, ReminderRule) error
+	}
+
+	DomainReminderRuleV1 struct {
+		dataReminderRuleV1 DataReminderRuleV1Adapter
+		auditWriter        a.AuditAdapter
+	}
+)
+
+func NewDomainReminderRuleV1(crrV1 DataReminderRuleV1Adapter) *DomainReminderRuleV1 {
+	aw := a.AuditInit()
+	return &DomainReminderRuleV1{dataReminderRuleV1: crrV1, auditWriter: aw}
+}
+
+func (m *DomainReminderRuleV1) Get(ctx context.Context, rr *ReminderRule) error {
+	if rr.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	return m.dataReminderRuleV1.Read(ctx, rr)
+}
+
+func (m *DomainReminderRuleV1) Search(ctx context.Context, rr *[]ReminderRule, param ReminderRuleParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("name", map[string]string{"id": "id", "name": "name", "channel": "channel", "offset_minutes": "offset_minutes", "cron": "cron", "active": "active", "last_run": "last_run"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataReminderRuleV1.ReadAll(ctx, rr, param)
+}
+
+func (m *DomainReminderRuleV1) Post(ctx context.Context, rr *ReminderRule) error {
+	if !rr.Name.Valid {
+		return ae.MissingParamError("Name")
+	}
+	if !rr.Channel.Valid {
+		return ae.MissingParamError("Channel")
+	}
+	if !rr.OffsetMinutes.Valid {
+		return ae.MissingParamError("OffsetMinutes")
+	}
+	if !rr.Active.Valid {
+		rr.Active = null.BoolFrom(true)
+	}
+	rr.LastRun = null.Time{}
+	if err := rr.validate(); err != nil {
+		return err
+	}
+	if err := m.dataReminderRuleV1.Create(ctx, rr); err != nil {
+		return err
+	}
+	go a.AuditCreate(m.auditWriter, *rr, ReminderRuleConst, a.KeysToString("id", rr.Id))
+	return nil
+}
+
+func (m *DomainReminderRuleV1) Patch(ctx context.Context, rrIn ReminderRule) error {
+	rr := &ReminderRule{Id: rrIn.Id}
+	errGet := m.dataReminderRuleV1.Read(ctx, rr)
+	if errGet != nil {
+		return errGet
+	}
+	existingValues := make(map[string]any)
+	// Name
+	if rrIn.Name.Valid {
+		existingValues["name"] = rr.Name.String
+		rr.Name = rrIn.Name
+	}
+	// Channel
+	if rrIn.Channel.Valid {
+		existingValues["channel"] = rr.Channel.String
+		rr.Channel = rrIn.Channel
+	}
+	// OffsetMinutes
+	if rrIn.OffsetMinutes.Valid {
+		existingValues["offset_minutes"] = rr.OffsetMinutes.Int64
+		rr.OffsetMinutes = rrIn.OffsetMinutes
+	}
+	// Cron
+	if rrIn.Cron.Valid {
+		existingValues["cron"] = rr.Cron.String
+		rr.Cron = rrIn.Cron
+	}
+	// Template
+	if rrIn.Template.Valid {
+		existingValues["template"] = rr.Template.String
+		rr.Template = rrIn.Template
+	}
+	// Active
+	if rrIn.Active.Valid {
+		existingValues["active"] = rr.Active.Bool
+		rr.Active = rrIn.Active
+	}
+	if err := rr.validate(); err != nil {
+		return err
+	}
+	if err := m.dataReminderRuleV1.Update(ctx, *rr); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *rr, ReminderRuleConst, a.KeysToString("id", rr.Id), existingValues)
+	return nil
+}
+
+func (m *DomainReminderRuleV1) Delete(ctx context.Context, rr *ReminderRule) error {
+	if rr.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataReminderRuleV1.Delete(ctx, rr); err != nil {
+		return err
+	}
+	go a.AuditDelete(m.auditWriter, *rr, ReminderRuleConst, a.KeysToString("id", rr.Id))
+	return nil
+}
+
+// DigestDue is true when the digest's cron has come around since it was last sent
+// a digest that was never sent is not due, SendEmail starts it counting from the first check
+func (rr ReminderRule) DigestDue(now time.Time, tz *time.Location) bool {
+	if !rr.LastRun.Valid {
+		return false
+	}
+	schedule, err := cron.Parse(rr.Cron.String)
+	if err != nil {
+		return false
+	}
+	next := schedule.Next(rr.LastRun.Time.In(tz))
+	return !next.IsZero() && !next.After(now)
+}
+
+func (rr ReminderRule) RenderIndividual(data ReminderData) (string, error) {
+	return rr.render(defaultIndividualTemplate, data)
+}
+
+func (rr ReminderRule) RenderDigest(bookings []ReminderData) (string, error) {
+	return rr.render(defaultDigestTemplate, DigestData{Rule: rr.Name.String, Bookings: bookings})
+}
+
+func (rr ReminderRule) render(defaultTemplate string, data any) (string, error) {
+	text := defaultTemplate
+	if rr.Template.Valid && rr.Template.String != "" {
+		text = rr.Template.String
+	}
+	tmpl, err := template.New(ReminderRuleConst).Parse(text)
+	if err != nil {
+		return "", ae.ParseError("Template: " + err.Error())
+	}
+	out := &bytes.Buffer{}
+	if err := tmpl.Execute(out, data); err != nil {
+		return "", ae.ParseError("Template: " + err.Error())
+	}
+	return out.String(), nil
+}
+
+func (rr ReminderRule) validate() error {
+	if len(rr.Name.ValueOrZero()) > 100 {
+		return ae.StringLengthError("Name", 100)
+	}
+	if rr.OffsetMinutes.Int64 < 0 {
+		return ae.ParseError("OffsetMinutes must not be negative")
+	}
+	switch rr.Channel.String {
+	case ChannelIndividual:
+		if _, err := rr.RenderIndividual(ReminderData{}); err != nil {
+			return err
+		}
+	case ChannelDigest:
+		if _, err := cron.Parse(rr.Cron.String); err != nil {
+			return ae.ParamError("Cron", err)
+		}
+		if _, err := rr.RenderDigest([]ReminderData{{}}); err != nil {
+			return err
+		}
+	default:
+		return ae.ParseError("Channel must be one of: " + ChannelIndividual + ", " + ChannelDigest)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package reminderrule
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDomainReminderRuleV1_Post(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataReminderRule := NewMockDataReminderRuleV1Adapter(ctrl)
+
+	tests := []struct {
+		name    string
+		rr      *ReminderRule
+		wantErr bool
+		calls   []*gomock.Call
+	}{
+		{
+			"successful - individual",
+			&ReminderRule{Name: null.StringFrom("2h before"), Channel: null.StringFrom(ChannelIndividual), OffsetMinutes: null.IntFrom(120), Template: null.StringFrom("See you at {{.Appointment}}")},
+			false,
+			[]*gomock.Call{mockDataReminderRule.EXPECT().Create(ctx, gomock.Any()).Return(nil)},
+		},
+		{
+			"successful - digest",
+			&ReminderRule{Name: null.StringFrom("Weekly digest"), Channel: null.StringFrom(ChannelDigest), OffsetMinutes: null.IntFrom(10080), Cron: null.StringFrom("0 23 * * fri")},
+			false,
+			[]*gomock.Call{mockDataReminderRule.EXPECT().Create(ctx, gomock.Any()).Return(nil)},
+		},
+		{
+			"failed - channel",
+			&ReminderRule{Name: null.StringFrom("2h before"), Channel: null.StringFrom("pigeon"), OffsetMinutes: null.IntFrom(120)},
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - digest cron",
+			&ReminderRule{Name: null.StringFrom("Weekly digest"), Channel: null.StringFrom(ChannelDigest), OffsetMinutes: null.IntFrom(10080)},
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - template",
+			&ReminderRule{Name: null.StringFrom("2h before"), Channel: null.StringFrom(ChannelIndividual), OffsetMinutes: null.IntFrom(120), Template: null.StringFrom("{{.Missing}}")},
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - offset",
+			&ReminderRule{Name: null.StringFrom("2h before"), Channel: null.StringFrom(ChannelIndividual)},
+			true,
+			[]*gomock.Call{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DomainReminderRuleV1{dataReminderRuleV1: mockDataReminderRule}
+			err := m.Post(ctx, tt.rr)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainReminderRuleV1.Post().%s => expected error: got: %s", tt.name, err)
+			if !tt.wantErr {
+				assert.True(t, tt.rr.Active.Bool, "DomainReminderRuleV1.Post().%s => expected active", tt.name)
+			}
+		})
+	}
+}
+
+func TestReminderRule_DigestDue(t *testing.T) {
+	tz, _ := time.LoadLocation("America/Denver")
+	// Friday 2025-12-05 23:00 in Denver
+	friday := time.Date(2025, 12, 5, 23, 0, 0, 0, tz).UTC()
+	rule := ReminderRule{Cron: null.StringFrom("0 23 * * fri")}
+
+	tests := []struct {
+		name    string
+		lastRun null.Time
+		now     time.Time
+		want    bool
+	}{
+		{"never run", null.Time{}, friday, false},
+		{"before the cron", null.TimeFrom(friday.AddDate(0, 0, -3)), friday.Add(-time.Minute), false},
+		{"at the cron", null.TimeFrom(friday.AddDate(0, 0, -3)), friday, true},
+		{"caught up after downtime", null.TimeFrom(friday.AddDate(0, 0, -3)), friday.AddDate(0, 0, 2), true},
+		{"already sent", null.TimeFrom(friday), friday.Add(5 * time.Minute), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule.LastRun = tt.lastRun
+			assert.Equal(t, tt.want, rule.DigestDue(tt.now, tz), "ReminderRule.DigestDue().%s", tt.name)
+		})
+	}
+}
+
+func TestReminderRule_Render(t *testing.T) {
+	booking := ReminderData{Name: "Smith", Email: "smith@example.com", Appointment: "Sunday, December 7, 2025, 09:00 AM - 09:20 AM"}
+
+	individual, err := ReminderRule{}.RenderIndividual(booking)
+	assert.Nil(t, err)
+	assert.Contains(t, individual, booking.Appointment)
+
+	custom, err := ReminderRule{Template: null.StringFrom("Hi {{.Name}}, see you {{.Appointment}}")}.RenderIndividual(booking)
+	assert.Nil(t, err)
+	assert.Equal(t, "Hi Smith, see you "+booking.Appointment, custom)
+
+	digest, err := ReminderRule{}.RenderDigest([]ReminderData{booking, {Appointment: "Sunday, December 7, 2025, 09:20 AM - 09:40 AM"}})
+	assert.Nil(t, err)
+	assert.Contains(t, digest, "- "+booking.Appointment+" for Smith (Email: smith@example.com)\n")
+	assert.Contains(t, digest, "09:40 AM for No Name\n")
+}
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package reminderrule is a generated GoMock package.
+package reminderrule
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataReminderRuleV1Adapter is a mock of DataReminderRuleV1Adapter interface.
+type MockDataReminderRuleV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataReminderRuleV1AdapterMockRecorder
+}
+
+// MockDataReminderRuleV1AdapterMockRecorder is the mock recorder for MockDataReminderRuleV1Adapter.
+type MockDataReminderRuleV1AdapterMockRecorder struct {
+	mock *MockDataReminderRuleV1Adapter
+}
+
+// NewMockDataReminderRuleV1Adapter creates a new mock instance.
+func NewMockDataReminderRuleV1Adapter(ctrl *gomock.Controller) *MockDataReminderRuleV1Adapter {
+	mock := &MockDataReminderRuleV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataReminderRuleV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataReminderRuleV1Adapter) EXPECT() *MockDataReminderRuleV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDataReminderRuleV1Adapter) Create(arg0 context.Context, arg1 *ReminderRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataReminderRuleV1AdapterMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataReminderRuleV1Adapter)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDataReminderRuleV1Adapter) Delete(arg0 context.Context, arg1 *ReminderRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataReminderRuleV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataReminderRuleV1Adapter)(nil).Delete), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockDataReminderRuleV1Adapter) Read(arg0 context.Context, arg1 *ReminderRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataReminderRuleV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataReminderRuleV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataReminderRuleV1Adapter) ReadAll(arg0 context.Context, arg1 *[]ReminderRule, arg2 ReminderRuleParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataReminderRuleV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataReminderRuleV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// SetLastRun mocks base method.
+func (m *MockDataReminderRuleV1Adapter) SetLastRun(arg0 context.Context, arg1 ReminderRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLastRun", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLastRun indicates an expected call of SetLastRun.
+func (mr *MockDataReminderRuleV1AdapterMockRecorder) SetLastRun(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastRun", reflect.TypeOf((*MockDataReminderRuleV1Adapter)(nil).SetLastRun), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockDataReminderRuleV1Adapter) Update(arg0 context.Context, arg1 ReminderRule) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataReminderRuleV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataReminderRuleV1Adapter)(nil).Update), arg0, arg1)
+}
@@ -0,0 +1,61 @@
+package reminderrule
+
+import (
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	// ReminderRule is when and how a reminder goes out
+	// individual: to the family, offset_minutes before each appointment
+	// digest: to the email_reminder addresses on the cron schedule, covering the appointments in the next offset_minutes
+	ReminderRule struct {
+		Id            int         `db:"id" json:"id"`
+		Name          null.String `db:"name" json:"name"`
+		Channel       null.String `db:"channel" json:"channel"`
+		OffsetMinutes null.Int    `db:"offset_minutes" json:"offset_minutes"`
+		Cron          null.String `db:"cron" json:"cron"`         // digest only, in the unit's timezone
+		Template      null.String `db:"template" json:"template"` // text/template, see ReminderData/DigestData for the fields, empty uses the default
+		Active        null.Bool   `db:"active" json:"active"`
+		LastRun       null.Time   `db:"last_run" json:"last_run"` // digest only, when it was last sent
+	}
+
+	ReminderRuleParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+
+	// ReminderData is one appointment as given to a template
+	ReminderData struct {
+		Name        string
+		Email       string
+		Phone       string
+		Appointment string // e.g.: Sunday, December 7, 2025, 09:00 AM - 09:20 AM
+	}
+
+	// DigestData is what a digest template is given
+	DigestData struct {
+		Rule     string
+		Bookings []ReminderData
+	}
+)
+
+const (
+	ReminderRuleConst = "reminder_rule"
+
+	ChannelIndividual = "individual"
+	ChannelDigest     = "digest"
+
+	defaultIndividualTemplate = `This is a reminder that you have an upcoming tithing declaration date scheduled for:
+
+{{.Appointment}}
+`
+	defaultDigestTemplate = `The following upcoming declaration dates have been scheduled:
+
+{{range .Bookings}}- {{.Appointment}} for {{if .Name}}{{.Name}}{{else}}No Name{{end}}{{if .Email}} (Email: {{.Email}}){{end}}
+{{end}}`
+)
+
+func InitStorageV1() DataReminderRuleV1Adapter {
+	return InitSQLV1()
+}
@@ -0,0 +1,157 @@
+package reminderrule
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestReminderRuleV1 struct{}
+)
+
+var (
+	restV1   RestReminderRuleV1
+	domainV1 *DomainReminderRuleV1
+)
+
+func InitializeReminderRuleV1() *DomainReminderRuleV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainReminderRuleV1(storV1)
+	restV1 = *NewRestReminderRuleV1()
+	return domainV1
+}
+
+func RegisterReminderRule(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/reminder-rule/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/reminder-rule/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/reminder-rule", Post)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/reminder-rule", Patch)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/reminder-rule/:id", Delete)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Post(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Post(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Patch(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Patch(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Delete(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Delete(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestReminderRuleV1() *RestReminderRuleV1 {
+	return &RestReminderRuleV1{}
+}
+
+func (h *RestReminderRuleV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	reminderRule := &ReminderRule{Id: int(id)}
+	if err := domainV1.Get(ctx, reminderRule); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *reminderRule, nil)
+}
+
+func (h *RestReminderRuleV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := ReminderRuleParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	reminderRules := &[]ReminderRule{}
+	totalCount, err := domainV1.Search(ctx, reminderRules, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *reminderRules, &totalCount)
+}
+
+func (h *RestReminderRuleV1) Post(c echo.Context) error {
+	ctx := context.Background()
+	rr := ReminderRule{}
+	if err := c.Bind(&rr); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Post(ctx, &rr); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, rr, nil)
+}
+
+func (h *RestReminderRuleV1) Patch(c echo.Context) error {
+	ctx := context.Background()
+	rr := ReminderRule{}
+	if err := c.Bind(&rr); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Patch(ctx, rr); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestReminderRuleV1) Delete(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	reminderRule := &ReminderRule{Id: int(id)}
+	if err := domainV1.Delete(ctx, reminderRule); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
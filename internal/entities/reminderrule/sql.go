@@ -0,0 +1,150 @@
+package reminderrule
+
+import (
+	"context"
+	"fmt"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLReminderRuleV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLReminderRuleV1 {
+	db := stor.InitStorage()
+	return &SQLReminderRuleV1{DB: db}
+}
+
+func (d *SQLReminderRuleV1) Read(ctx context.Context, rr *ReminderRule) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			channel,
+			offset_minutes,
+			cron,
+			template,
+			active,
+			last_run
+		FROM reminder_rule WHERE id = $1`
+	if errDB := d.DB.Get(rr, sqlGet, rr.Id); errDB != nil {
+		return ae.DBError("ReminderRule Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLReminderRuleV1) ReadAll(ctx context.Context, rr *[]ReminderRule, param ReminderRuleParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			channel,
+			offset_minutes,
+			cron,
+			template,
+			active,
+			last_run
+		FROM reminder_rule
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(rr, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("ReminderRule ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM reminder_rule
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("reminder_rule ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLReminderRuleV1) Create(ctx context.Context, rr *ReminderRule) error {
+	count, errCount := d.count()
+	if errCount != nil {
+		return errCount
+	}
+	rr.Id = count
+	sqlPost := `
+		INSERT INTO reminder_rule (
+			id,
+			name,
+			channel,
+			offset_minutes,
+			cron,
+			template,
+			active,
+			last_run
+		) VALUES (
+			:id,
+			:name,
+			:channel,
+			:offset_minutes,
+			:cron,
+			:template,
+			:active,
+			:last_run
+		)`
+	_, errDB := d.DB.NamedExec(sqlPost, rr)
+	if errDB != nil {
+		return ae.DBError("ReminderRule Post: unable to insert record.", errDB)
+	}
+
+	return nil
+}
+
+func (d *SQLReminderRuleV1) Update(ctx context.Context, rr ReminderRule) error {
+	sqlPatch := `
+		UPDATE reminder_rule SET
+			name = :name,
+			channel = :channel,
+			offset_minutes = :offset_minutes,
+			cron = :cron,
+			template = :template,
+			active = :active
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, rr); errDB != nil {
+		return ae.DBError("ReminderRule Patch: unable to update record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLReminderRuleV1) Delete(ctx context.Context, rr *ReminderRule) error {
+	sqlDelete := `
+		DELETE FROM reminder_rule WHERE id = $1`
+	if _, errDB := d.DB.Exec(sqlDelete, rr.Id); errDB != nil {
+		return ae.DBError("ReminderRule Delete: unable to delete record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLReminderRuleV1) SetLastRun(ctx context.Context, rr ReminderRule) error {
+	sqlLastRun := `
+		UPDATE reminder_rule SET
+			last_run = :last_run
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlLastRun, rr); errDB != nil {
+		return ae.DBError("ReminderRule SetLastRun: unable to update record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLReminderRuleV1) count() (int, error) {
+	count := 0
+	if errDB := d.DB.Get(&count, "SELECT COALESCE(MAX(id), 0) FROM reminder_rule"); errDB != nil {
+		return 0, ae.DBError("ReminderRule count: unable to get count.", errDB)
+	}
+	return count + 1, nil
+}
@@ -0,0 +1,163 @@
+package schedulerjob
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/cron"
+	"gopkg.in/guregu/null.v3"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=schedulerjob
+type (
+	DataSchedulerJobV1Adapter interface {
+		ReadAll(context.Context, *[]SchedulerJob, SchedulerJobParam) (int, error)
+		ReadByName(context.Context, *SchedulerJob) error
+		Register(context.Context, *SchedulerJob) error
+		Claim(context.Context, *SchedulerJob, time.Time) (bool, error)
+		Finish(context.Context, SchedulerJob) error
+	}
+
+	registeredJob struct {
+		Job
+		schedule cron.Schedule
+	}
+
+	DomainSchedulerJobV1 struct {
+		dataSchedulerJobV1 DataSchedulerJobV1Adapter
+		jobs               []registeredJob
+		inFlight           sync.WaitGroup
+		scheduler          *Scheduler
+	}
+)
+
+func NewDomainSchedulerJobV1(csjV1 DataSchedulerJobV1Adapter) *DomainSchedulerJobV1 {
+	return &DomainSchedulerJobV1{dataSchedulerJobV1: csjV1}
+}
+
+func (m *DomainSchedulerJobV1) Search(ctx context.Context, sj *[]SchedulerJob, param SchedulerJobParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("name", map[string]string{"id": "id", "name": "name", "cron": "cron", "last_run": "last_run", "next_run": "next_run", "running_since": "running_since", "last_error": "last_error", "last_duration": "last_duration"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataSchedulerJobV1.ReadAll(ctx, sj, param)
+}
+
+// Register adds the job, the stored next run is kept (so a run missed while down is caught up) unless the cron changed
+// call before the scheduler is started
+func (m *DomainSchedulerJobV1) Register(ctx context.Context, job Job) error {
+	if job.Name == "" {
+		return ae.MissingParamError("Name")
+	}
+	if job.Run == nil {
+		return ae.MissingParamError("Run")
+	}
+	schedule, err := cron.Parse(job.Cron)
+	if err != nil {
+		return ae.ParamError("Cron", err)
+	}
+	if job.Timeout <= 0 {
+		job.Timeout = defaultJobTimeout
+	}
+	sj := &SchedulerJob{
+		Name:    null.StringFrom(job.Name),
+		Cron:    null.StringFrom(job.Cron),
+		NextRun: nextRun(schedule, time.Now()),
+	}
+	if err := m.dataSchedulerJobV1.Register(ctx, sj); err != nil {
+		return err
+	}
+	m.jobs = append(m.jobs, registeredJob{Job: job, schedule: schedule})
+	return nil
+}
+
+// RunDue starts every job whose next run has passed and returns the earliest next run of the jobs not running
+// a zero time means there is nothing to wait on
+func (m *DomainSchedulerJobV1) RunDue(ctx context.Context) (time.Time, error) {
+	now := time.Now().UTC()
+	next := time.Time{}
+	for _, job := range m.jobs {
+		sj := &SchedulerJob{Name: null.StringFrom(job.Name)}
+		if err := m.dataSchedulerJobV1.ReadByName(ctx, sj); err != nil {
+			return next, err
+		}
+		if sj.NextRun.Valid && !sj.NextRun.Time.After(now) {
+			if err := m.start(ctx, job); err != nil {
+				// most likely still running from before, a finished run wakes the scheduler
+				logging.Default.Printf("scheduler job: %s not started: %s", job.Name, err)
+			}
+			continue
+		}
+		if sj.NextRun.Valid && (next.IsZero() || sj.NextRun.Time.Before(next)) {
+			next = sj.NextRun.Time
+		}
+	}
+	return next, nil
+}
+
+// Trigger runs the job now, it does not wait for the run to finish
+func (m *DomainSchedulerJobV1) Trigger(ctx context.Context, name string) error {
+	for _, job := range m.jobs {
+		if job.Name == name {
+			return m.start(ctx, job)
+		}
+	}
+	return ae.JobNotFoundError(name)
+}
+
+// Wait blocks until all the runs in progress finish
+func (m *DomainSchedulerJobV1) Wait() {
+	m.inFlight.Wait()
+}
+
+// start claims the job so it can't overlap with a run in progress (here or in another instance) and runs it
+func (m *DomainSchedulerJobV1) start(ctx context.Context, job registeredJob) error {
+	started := time.Now().UTC()
+	sj := &SchedulerJob{Name: null.StringFrom(job.Name), RunningSince: null.TimeFrom(started)}
+	claimed, err := m.dataSchedulerJobV1.Claim(ctx, sj, started.Add(-job.Timeout))
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return ae.JobRunningError(job.Name)
+	}
+	m.inFlight.Add(1)
+	go func() {
+		defer m.inFlight.Done()
+		runCtx, cancel := context.WithTimeout(context.Background(), job.Timeout)
+		defer cancel()
+		errRun := job.Run(runCtx)
+		finished := time.Now()
+		sj.LastRun = null.TimeFrom(started)
+		sj.LastDuration = null.IntFrom(finished.Sub(started).Milliseconds())
+		sj.LastError = null.String{}
+		if errRun != nil {
+			logging.Default.Printf("scheduler job: %s failed: %s", job.Name, errRun)
+			sj.LastError = null.StringFrom(errRun.Error())
+		}
+		sj.NextRun = nextRun(job.schedule, finished)
+		sj.RunningSince = null.Time{}
+		if err := m.dataSchedulerJobV1.Finish(context.Background(), *sj); err != nil {
+			logging.Default.Printf("scheduler job: %s unable to save run: %s", job.Name, err)
+		}
+		if m.scheduler != nil {
+			m.scheduler.Wake()
+		}
+	}()
+	return nil
+}
+
+// nextRun is the next time the schedule matches after t, evaluated in the unit's timezone and stored as UTC
+func nextRun(schedule cron.Schedule, t time.Time) null.Time {
+	next := schedule.Next(t.In(config.Sch.GetTimezone()))
+	if next.IsZero() {
+		return null.Time{}
+	}
+	return null.TimeFrom(next.UTC())
+}
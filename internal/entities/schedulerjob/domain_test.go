@@ -0,0 +1,162 @@
+package schedulerjob
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDomainSchedulerJobV1_Register(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataSchedulerJob := NewMockDataSchedulerJobV1Adapter(ctrl)
+	run := func(context.Context) error { return nil }
+
+	tests := []struct {
+		name    string
+		job     Job
+		wantErr bool
+		calls   []*gomock.Call
+	}{
+		{
+			"successful",
+			Job{Name: "email-reminder", Cron: "0 23 * * fri", Run: run},
+			false,
+			[]*gomock.Call{mockDataSchedulerJob.EXPECT().Register(ctx, gomock.Any()).Return(nil)},
+		},
+		{
+			"failed - name",
+			Job{Cron: "0 23 * * fri", Run: run},
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - cron",
+			Job{Name: "email-reminder", Cron: "every friday", Run: run},
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - run",
+			Job{Name: "email-reminder", Cron: "0 23 * * fri"},
+			true,
+			[]*gomock.Call{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DomainSchedulerJobV1{dataSchedulerJobV1: mockDataSchedulerJob}
+			err := m.Register(ctx, tt.job)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainSchedulerJobV1.Register().%s => expected error: got: %s", tt.name, err)
+			if !tt.wantErr {
+				assert.Equal(t, time.Hour, m.jobs[0].Timeout, "DomainSchedulerJobV1.Register().%s => default timeout not set", tt.name)
+			}
+		})
+	}
+}
+
+func TestDomainSchedulerJobV1_RunDue(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataSchedulerJob := NewMockDataSchedulerJobV1Adapter(ctrl)
+
+	now := time.Now().UTC()
+	later := now.Add(2 * time.Hour)
+	state := map[string]null.Time{
+		"due":     null.TimeFrom(now.Add(-3 * 24 * time.Hour)), // missed while down, caught up once
+		"not-due": null.TimeFrom(later),
+		"running": null.TimeFrom(now.Add(-time.Minute)),
+	}
+	mockDataSchedulerJob.EXPECT().Register(ctx, gomock.Any()).Return(nil).Times(3)
+	mockDataSchedulerJob.EXPECT().ReadByName(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, sj *SchedulerJob) error {
+		sj.NextRun = state[sj.Name.String]
+		return nil
+	}).Times(3)
+	mockDataSchedulerJob.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sj *SchedulerJob, _ time.Time) (bool, error) {
+		return sj.Name.String == "due", nil
+	}).Times(2)
+	finished := make(chan SchedulerJob, 1)
+	mockDataSchedulerJob.EXPECT().Finish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sj SchedulerJob) error {
+		finished <- sj
+		return nil
+	})
+
+	ran := map[string]int{}
+	m := &DomainSchedulerJobV1{dataSchedulerJobV1: mockDataSchedulerJob}
+	for _, name := range []string{"due", "not-due", "running"} {
+		job := Job{Name: name, Cron: "*/5 * * * *", Run: func(context.Context) error {
+			ran[name]++
+			return errors.New("boom")
+		}}
+		assert.Nil(t, m.Register(ctx, job))
+	}
+	next, err := m.RunDue(ctx)
+	assert.Nil(t, err, "DomainSchedulerJobV1.RunDue() => expected not error; got: %s", err)
+	assert.True(t, later.Equal(next), "DomainSchedulerJobV1.RunDue() => next: got: %s, want: %s", next, later)
+
+	select {
+	case sj := <-finished:
+		assert.Equal(t, "due", sj.Name.String)
+		assert.Equal(t, "boom", sj.LastError.String, "DomainSchedulerJobV1.RunDue() => run error not saved")
+		assert.False(t, sj.RunningSince.Valid, "DomainSchedulerJobV1.RunDue() => running not cleared")
+		assert.True(t, sj.NextRun.Time.After(now), "DomainSchedulerJobV1.RunDue() => next run not moved forward")
+	case <-time.After(time.Second):
+		t.Error("DomainSchedulerJobV1.RunDue() => due job not run")
+	}
+	m.Wait()
+	assert.Equal(t, map[string]int{"due": 1}, ran, "DomainSchedulerJobV1.RunDue() => unexpected runs")
+}
+
+func TestDomainSchedulerJobV1_Trigger(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataSchedulerJob := NewMockDataSchedulerJobV1Adapter(ctrl)
+
+	mockDataSchedulerJob.EXPECT().Register(ctx, gomock.Any()).Return(nil)
+	m := &DomainSchedulerJobV1{dataSchedulerJobV1: mockDataSchedulerJob}
+	assert.Nil(t, m.Register(ctx, Job{Name: "email-reminder", Cron: "0 23 * * fri", Run: func(context.Context) error { return nil }}))
+
+	tests := []struct {
+		name    string
+		job     string
+		wantErr bool
+		calls   func()
+	}{
+		{
+			"successful",
+			"email-reminder",
+			false,
+			func() {
+				mockDataSchedulerJob.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(true, nil)
+				mockDataSchedulerJob.EXPECT().Finish(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			"failed - already running",
+			"email-reminder",
+			true,
+			func() {
+				mockDataSchedulerJob.EXPECT().Claim(ctx, gomock.Any(), gomock.Any()).Return(false, nil)
+			},
+		},
+		{
+			"failed - unknown job",
+			"nightly",
+			true,
+			func() {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.calls()
+			err := m.Trigger(ctx, tt.job)
+			m.Wait()
+			assert.Equal(t, tt.wantErr, err != nil, "DomainSchedulerJobV1.Trigger().%s => expected error: got: %s", tt.name, err)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package schedulerjob is a generated GoMock package.
+package schedulerjob
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataSchedulerJobV1Adapter is a mock of DataSchedulerJobV1Adapter interface.
+type MockDataSchedulerJobV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataSchedulerJobV1AdapterMockRecorder
+}
+
+// MockDataSchedulerJobV1AdapterMockRecorder is the mock recorder for MockDataSchedulerJobV1Adapter.
+type MockDataSchedulerJobV1AdapterMockRecorder struct {
+	mock *MockDataSchedulerJobV1Adapter
+}
+
+// NewMockDataSchedulerJobV1Adapter creates a new mock instance.
+func NewMockDataSchedulerJobV1Adapter(ctrl *gomock.Controller) *MockDataSchedulerJobV1Adapter {
+	mock := &MockDataSchedulerJobV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataSchedulerJobV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataSchedulerJobV1Adapter) EXPECT() *MockDataSchedulerJobV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Claim mocks base method.
+func (m *MockDataSchedulerJobV1Adapter) Claim(arg0 context.Context, arg1 *SchedulerJob, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Claim", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim.
+func (mr *MockDataSchedulerJobV1AdapterMockRecorder) Claim(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockDataSchedulerJobV1Adapter)(nil).Claim), arg0, arg1, arg2)
+}
+
+// Finish mocks base method.
+func (m *MockDataSchedulerJobV1Adapter) Finish(arg0 context.Context, arg1 SchedulerJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Finish", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Finish indicates an expected call of Finish.
+func (mr *MockDataSchedulerJobV1AdapterMockRecorder) Finish(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Finish", reflect.TypeOf((*MockDataSchedulerJobV1Adapter)(nil).Finish), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataSchedulerJobV1Adapter) ReadAll(arg0 context.Context, arg1 *[]SchedulerJob, arg2 SchedulerJobParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataSchedulerJobV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataSchedulerJobV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// ReadByName mocks base method.
+func (m *MockDataSchedulerJobV1Adapter) ReadByName(arg0 context.Context, arg1 *SchedulerJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadByName", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadByName indicates an expected call of ReadByName.
+func (mr *MockDataSchedulerJobV1AdapterMockRecorder) ReadByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByName", reflect.TypeOf((*MockDataSchedulerJobV1Adapter)(nil).ReadByName), arg0, arg1)
+}
+
+// Register mocks base method.
+func (m *MockDataSchedulerJobV1Adapter) Register(arg0 context.Context, arg1 *SchedulerJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Register", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockDataSchedulerJobV1AdapterMockRecorder) Register(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockDataSchedulerJobV1Adapter)(nil).Register), arg0, arg1)
+}
@@ -0,0 +1,45 @@
+package schedulerjob
+
+import (
+	"context"
+	"time"
+
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	// SchedulerJob is the persisted state of a job, the jobs themselves are registered in code
+	SchedulerJob struct {
+		Id           int         `db:"id" json:"id"`
+		Name         null.String `db:"name" json:"name"`
+		Cron         null.String `db:"cron" json:"cron"`
+		LastRun      null.Time   `db:"last_run" json:"last_run"`
+		NextRun      null.Time   `db:"next_run" json:"next_run"`
+		RunningSince null.Time   `db:"running_since" json:"running_since"` // set while a run is in progress, guards against overlapping runs
+		LastError    null.String `db:"last_error" json:"last_error"`
+		LastDuration null.Int    `db:"last_duration" json:"last_duration"` // in milliseconds
+	}
+
+	SchedulerJobParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+
+	// Job is a unit of work run on a cron schedule (in the unit's timezone)
+	Job struct {
+		Name    string
+		Cron    string
+		Timeout time.Duration // defaults to an hour, a run older than this is considered dead and can be started again
+		Run     func(context.Context) error
+	}
+)
+
+const (
+	SchedulerJobConst = "scheduler_job"
+	defaultJobTimeout = time.Hour
+)
+
+func InitStorageV1() DataSchedulerJobV1Adapter {
+	return InitSQLV1()
+}
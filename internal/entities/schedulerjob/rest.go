@@ -0,0 +1,99 @@
+package schedulerjob
+
+import (
+	"context"
+	"net/http"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestSchedulerJobV1 struct{}
+)
+
+var (
+	restV1   RestSchedulerJobV1
+	domainV1 *DomainSchedulerJobV1
+)
+
+func InitializeSchedulerJobV1() *DomainSchedulerJobV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainSchedulerJobV1(storV1)
+	restV1 = *NewRestSchedulerJobV1()
+	return domainV1
+}
+
+func RegisterSchedulerJob(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/scheduler-job", List)
+	r.RegisterAndAdd(eg, http.MethodPost, "/scheduler-job/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/scheduler-job/run/:name", Run)
+}
+
+func List(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.List(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Run(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Run(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestSchedulerJobV1() *RestSchedulerJobV1 {
+	return &RestSchedulerJobV1{}
+}
+
+func (h *RestSchedulerJobV1) List(c echo.Context) error {
+	ctx := context.Background()
+	schedulerJobs := &[]SchedulerJob{}
+	totalCount, err := domainV1.Search(ctx, schedulerJobs, SchedulerJobParam{})
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *schedulerJobs, &totalCount)
+}
+
+func (h *RestSchedulerJobV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := SchedulerJobParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	schedulerJobs := &[]SchedulerJob{}
+	totalCount, err := domainV1.Search(ctx, schedulerJobs, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *schedulerJobs, &totalCount)
+}
+
+// Run starts the job now, the run's outcome shows up on the job once it finishes
+func (h *RestSchedulerJobV1) Run(c echo.Context) error {
+	ctx := context.Background()
+	if err := domainV1.Trigger(ctx, c.Param("name")); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusAccepted)
+}
@@ -0,0 +1,84 @@
+package schedulerjob
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+)
+
+type (
+	// Scheduler runs the registered jobs, it sleeps until the next job is due
+	// a finished run wakes it up to pick up the job's new next run
+	Scheduler struct {
+		domain *DomainSchedulerJobV1
+		wake   chan struct{}
+		stop   chan struct{}
+		done   chan struct{}
+	}
+)
+
+// the longest the scheduler sleeps, covers runs left behind by another instance or a crash
+const maxSchedulerWait = time.Minute
+
+var schedulerV1 *Scheduler
+
+// InitializeSchedulerV1 hooks the scheduler up to the domain built by InitializeSchedulerJobV1
+func InitializeSchedulerV1() *Scheduler {
+	schedulerV1 = NewScheduler(domainV1)
+	domainV1.scheduler = schedulerV1
+	return schedulerV1
+}
+
+func NewScheduler(domain *DomainSchedulerJobV1) *Scheduler {
+	return &Scheduler{domain: domain, wake: make(chan struct{}, 1)}
+}
+
+func (s *Scheduler) Start() {
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run()
+}
+
+// Stop waits for the runs in progress to finish
+func (s *Scheduler) Stop() {
+	if s.stop == nil {
+		return
+	}
+	close(s.stop)
+	<-s.done
+	s.stop = nil
+	s.domain.Wait()
+}
+
+// Wake never blocks, a wake up already pending covers this one
+func (s *Scheduler) Wake() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Scheduler) run() {
+	defer close(s.done)
+	ctx := context.Background()
+	timer := time.NewTimer(0) // run right away for anything missed while stopped
+	defer timer.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-s.wake:
+		case <-timer.C:
+		}
+		next, err := s.domain.RunDue(ctx)
+		if err != nil {
+			logging.Default.Println("Error running scheduler jobs:", err)
+		}
+		wait := maxSchedulerWait
+		if !next.IsZero() && time.Until(next) < wait {
+			wait = max(time.Until(next), 0)
+		}
+		timer.Reset(wait)
+	}
+}
@@ -0,0 +1,124 @@
+package schedulerjob
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLSchedulerJobV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLSchedulerJobV1 {
+	db := stor.InitStorage()
+	return &SQLSchedulerJobV1{DB: db}
+}
+
+func (d *SQLSchedulerJobV1) ReadAll(ctx context.Context, sj *[]SchedulerJob, param SchedulerJobParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			cron,
+			last_run,
+			next_run,
+			running_since,
+			last_error,
+			last_duration
+		FROM scheduler_job
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(sj, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("SchedulerJob ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM scheduler_job
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("scheduler_job ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLSchedulerJobV1) ReadByName(ctx context.Context, sj *SchedulerJob) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			cron,
+			last_run,
+			next_run,
+			running_since,
+			last_error,
+			last_duration
+		FROM scheduler_job WHERE name = $1`
+	if errDB := d.DB.Get(sj, sqlGet, sj.Name); errDB != nil {
+		return ae.DBError("SchedulerJob ReadByName: unable to get record.", errDB)
+	}
+	return nil
+}
+
+// inserts the job or updates the cron of an existing one, next_run is only replaced when the cron changed or was never set
+func (d *SQLSchedulerJobV1) Register(ctx context.Context, sj *SchedulerJob) error {
+	sqlRegister := `
+		INSERT INTO scheduler_job (
+			id,
+			name,
+			cron,
+			next_run
+		) VALUES (
+			(SELECT COALESCE(MAX(id), 0) + 1 FROM scheduler_job),
+			:name,
+			:cron,
+			:next_run
+		) ON CONFLICT (name) DO UPDATE SET
+			next_run = CASE WHEN scheduler_job.cron != excluded.cron OR scheduler_job.next_run IS NULL THEN excluded.next_run ELSE scheduler_job.next_run END,
+			cron = excluded.cron`
+	if _, errDB := d.DB.NamedExec(sqlRegister, sj); errDB != nil {
+		return ae.DBError("SchedulerJob Register: unable to insert record.", errDB)
+	}
+	return d.ReadByName(ctx, sj)
+}
+
+// marks the job as running, false if it is already running (and not older than staleBefore)
+func (d *SQLSchedulerJobV1) Claim(ctx context.Context, sj *SchedulerJob, staleBefore time.Time) (bool, error) {
+	sqlClaim := `
+		UPDATE scheduler_job SET
+			running_since = $1
+		WHERE name = $2 AND (running_since IS NULL OR running_since < $3)`
+	result, errDB := d.DB.Exec(sqlClaim, sj.RunningSince, sj.Name, staleBefore)
+	if errDB != nil {
+		return false, ae.DBError("SchedulerJob Claim: unable to update record.", errDB)
+	}
+	rows, _ := result.RowsAffected()
+	return rows > 0, nil
+}
+
+func (d *SQLSchedulerJobV1) Finish(ctx context.Context, sj SchedulerJob) error {
+	sqlFinish := `
+		UPDATE scheduler_job SET
+			last_run = :last_run,
+			next_run = :next_run,
+			running_since = :running_since,
+			last_error = :last_error,
+			last_duration = :last_duration
+		WHERE name = :name`
+	if _, errDB := d.DB.NamedExec(sqlFinish, sj); errDB != nil {
+		return ae.DBError("SchedulerJob Finish: unable to update record.", errDB)
+	}
+	return nil
+}
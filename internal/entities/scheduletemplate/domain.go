@@ -0,0 +1,274 @@
+package scheduletemplate
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/function"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=scheduletemplate
+type (
+	DataScheduleTemplateV1Adapter interface {
+		Read(context.Context, *ScheduleTemplate) error
+		ReadAll(context.Context, *[]ScheduleTemplate, ScheduleTemplateParam) (int, error)
+		Create(context.Context, *ScheduleTemplate) error
+		Update(context.Context, ScheduleTemplate) error
+		Delete(context.Context, *ScheduleTemplate) error
+	}
+
+	DomainScheduleTemplateV1 struct {
+		dataScheduleTemplateV1 DataScheduleTemplateV1Adapter
+		auditWriter            a.AuditAdapter
+	}
+)
+
+func NewDomainScheduleTemplateV1(cstV1 DataScheduleTemplateV1Adapter) *DomainScheduleTemplateV1 {
+	aw := a.AuditInit()
+	return &DomainScheduleTemplateV1{dataScheduleTemplateV1: cstV1, auditWriter: aw}
+}
+
+func (m *DomainScheduleTemplateV1) Get(ctx context.Context, st *ScheduleTemplate) error {
+	if st.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	return m.dataScheduleTemplateV1.Read(ctx, st)
+}
+
+func (m *DomainScheduleTemplateV1) Search(ctx context.Context, st *[]ScheduleTemplate, param ScheduleTemplateParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("start_date", map[string]string{"id": "id", "name": "name", "start_date": "start_date", "end_date": "end_date", "start_time": "start_time", "end_time": "end_time"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataScheduleTemplateV1.ReadAll(ctx, st, param)
+}
+
+func (m *DomainScheduleTemplateV1) Post(ctx context.Context, st *ScheduleTemplate) error {
+	if !st.Name.Valid {
+		return ae.MissingParamError("Name")
+	}
+	if !st.StartDate.Valid {
+		return ae.MissingParamError("StartDate")
+	}
+	if !st.EndDate.Valid {
+		return ae.MissingParamError("EndDate")
+	}
+	if !st.StartTime.Valid {
+		return ae.MissingParamError("StartTime")
+	}
+	if !st.EndTime.Valid {
+		return ae.MissingParamError("EndTime")
+	}
+	if st.Weekdays == nil {
+		return ae.MissingParamError("Weekdays")
+	}
+	if err := st.validate(); err != nil {
+		return err
+	}
+	if err := m.dataScheduleTemplateV1.Create(ctx, st); err != nil {
+		return err
+	}
+	go a.AuditCreate(m.auditWriter, *st, ScheduleTemplateConst, a.KeysToString("id", st.Id))
+	return nil
+}
+
+func (m *DomainScheduleTemplateV1) Patch(ctx context.Context, stIn ScheduleTemplate) error {
+	st := &ScheduleTemplate{Id: stIn.Id}
+	errGet := m.dataScheduleTemplateV1.Read(ctx, st)
+	if errGet != nil {
+		return errGet
+	}
+	existingValues := make(map[string]any)
+	// Name
+	if stIn.Name.Valid {
+		existingValues["name"] = st.Name.String
+		st.Name = stIn.Name
+	}
+	// StartDate
+	if stIn.StartDate.Valid {
+		existingValues["start_date"] = st.StartDate.String
+		st.StartDate = stIn.StartDate
+	}
+	// EndDate
+	if stIn.EndDate.Valid {
+		existingValues["end_date"] = st.EndDate.String
+		st.EndDate = stIn.EndDate
+	}
+	// StartTime
+	if stIn.StartTime.Valid {
+		existingValues["start_time"] = st.StartTime.String
+		st.StartTime = stIn.StartTime
+	}
+	// EndTime
+	if stIn.EndTime.Valid {
+		existingValues["end_time"] = st.EndTime.String
+		st.EndTime = stIn.EndTime
+	}
+	// Weekdays
+	if stIn.Weekdays != nil {
+		existingValues["weekdays"] = st.Weekdays
+		st.Weekdays = stIn.Weekdays
+	}
+	// ExcludedDates
+	if stIn.ExcludedDates != nil {
+		existingValues["excluded_dates"] = st.ExcludedDates
+		st.ExcludedDates = stIn.ExcludedDates
+	}
+	// SlotDuration
+	if stIn.SlotDuration.Valid {
+		existingValues["slot_duration"] = st.SlotDuration.Int64
+		st.SlotDuration = stIn.SlotDuration
+	}
+	// BufferTime
+	if stIn.BufferTime.Valid {
+		existingValues["buffer_time"] = st.BufferTime.Int64
+		st.BufferTime = stIn.BufferTime
+	}
+	if err := st.validate(); err != nil {
+		return err
+	}
+	if err := m.dataScheduleTemplateV1.Update(ctx, *st); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *st, ScheduleTemplateConst, a.KeysToString("id", st.Id), existingValues)
+	return nil
+}
+
+func (m *DomainScheduleTemplateV1) Delete(ctx context.Context, st *ScheduleTemplate) error {
+	if st.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataScheduleTemplateV1.Delete(ctx, st); err != nil {
+		return err
+	}
+	go a.AuditDelete(m.auditWriter, *st, ScheduleTemplateConst, a.KeysToString("id", st.Id))
+	return nil
+}
+
+// Windows expands the recurrence rule into one window per matching day
+// start_date/end_date are inclusive, excluded_dates are skipped
+// the times are wall-clock times in tz, the windows are returned in UTC
+func (st ScheduleTemplate) Windows(tz *time.Location) ([]Window, error) {
+	if err := st.validate(); err != nil {
+		return nil, err
+	}
+	startDate, _ := time.Parse(layoutDate, st.StartDate.String)
+	endDate, _ := time.Parse(layoutDate, st.EndDate.String)
+	startTime, _ := time.Parse(layoutTime, st.StartTime.String)
+	endTime, _ := time.Parse(layoutTime, st.EndTime.String)
+	weekdays, _ := parseWeekdays(st.Weekdays)
+	excluded, _ := parseExcludedDates(st.ExcludedDates)
+
+	windows := []Window{}
+	for day := startDate; !day.After(endDate); day = day.AddDate(0, 0, 1) {
+		if !slices.Contains(weekdays, day.Weekday()) {
+			continue
+		}
+		if slices.Contains(excluded, day.Format(layoutDate)) {
+			continue
+		}
+		start := time.Date(day.Year(), day.Month(), day.Day(), startTime.Hour(), startTime.Minute(), 0, 0, tz).UTC()
+		end := time.Date(day.Year(), day.Month(), day.Day(), endTime.Hour(), endTime.Minute(), 0, 0, tz).UTC()
+		windows = append(windows, Window{Start: start, End: end})
+	}
+	return windows, nil
+}
+
+func (st ScheduleTemplate) validate() error {
+	if len(st.Name.ValueOrZero()) > 100 {
+		return ae.StringLengthError("Name", 100)
+	}
+	startDate, errStart := time.Parse(layoutDate, st.StartDate.String)
+	if errStart != nil {
+		return ae.ParseError("StartDate not in correct format")
+	}
+	endDate, errEnd := time.Parse(layoutDate, st.EndDate.String)
+	if errEnd != nil {
+		return ae.ParseError("EndDate not in correct format")
+	}
+	if endDate.Before(startDate) {
+		return ae.ParseError("EndDate must not be before StartDate")
+	}
+	startTime, errStart := time.Parse(layoutTime, st.StartTime.String)
+	if errStart != nil {
+		return ae.ParseError("StartTime not in correct format")
+	}
+	endTime, errEnd := time.Parse(layoutTime, st.EndTime.String)
+	if errEnd != nil {
+		return ae.ParseError("EndTime not in correct format")
+	}
+	if !endTime.After(startTime) {
+		return ae.ParseError("EndTime must be after StartTime")
+	}
+	if st.SlotDuration.Valid && st.SlotDuration.Int64 < 1 {
+		return ae.ParseError("SlotDuration must be at least 1 minute")
+	}
+	if st.BufferTime.Valid && st.BufferTime.Int64 < 0 {
+		return ae.ParseError("BufferTime must not be negative")
+	}
+	if _, err := parseWeekdays(st.Weekdays); err != nil {
+		return err
+	}
+	if _, err := parseExcludedDates(st.ExcludedDates); err != nil {
+		return err
+	}
+	return nil
+}
+
+// weekdays are stored as a json array of day names, e.g.: ["sunday", "wed"]
+func parseWeekdays(raw *json.RawMessage) ([]time.Weekday, error) {
+	if raw == nil || !function.ValidJson(*raw) {
+		return nil, ae.ParseError("Invalid JSON syntax for Weekdays")
+	}
+	names := []string{}
+	if err := json.Unmarshal(*raw, &names); err != nil {
+		return nil, ae.ParseError("Invalid JSON syntax for Weekdays")
+	}
+	if len(names) == 0 {
+		return nil, ae.MissingParamError("Weekdays")
+	}
+	weekdays := []time.Weekday{}
+	for _, name := range names {
+		found := false
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+			dayName := strings.ToLower(wd.String())
+			lowerName := strings.ToLower(strings.TrimSpace(name))
+			if lowerName == dayName || (len(lowerName) == 3 && lowerName == dayName[:3]) {
+				weekdays = append(weekdays, wd)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, ae.ParseError("Weekdays contains an unknown day: " + name)
+		}
+	}
+	return weekdays, nil
+}
+
+// excluded dates are stored as a json array of YYYY-MM-DD strings
+func parseExcludedDates(raw *json.RawMessage) ([]string, error) {
+	excluded := []string{}
+	if raw == nil || string(*raw) == "null" {
+		return excluded, nil
+	}
+	if !function.ValidJson(*raw) {
+		return nil, ae.ParseError("Invalid JSON syntax for ExcludedDates")
+	}
+	if err := json.Unmarshal(*raw, &excluded); err != nil {
+		return nil, ae.ParseError("Invalid JSON syntax for ExcludedDates")
+	}
+	for _, ex := range excluded {
+		if _, err := time.Parse(layoutDate, ex); err != nil {
+			return nil, ae.ParseError("ExcludedDates not in correct format")
+		}
+	}
+	return excluded, nil
+}
@@ -0,0 +1,143 @@
+package scheduletemplate
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func rawJson(s string) *json.RawMessage {
+	r := json.RawMessage(s)
+	return &r
+}
+
+func TestDomainScheduleTemplateV1_Post(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataScheduleTemplate := NewMockDataScheduleTemplateV1Adapter(ctrl)
+
+	valid := func() *ScheduleTemplate {
+		return &ScheduleTemplate{
+			Name:      null.StringFrom("Sundays"),
+			StartDate: null.StringFrom("2025-11-02"),
+			EndDate:   null.StringFrom("2025-12-28"),
+			StartTime: null.StringFrom("09:00"),
+			EndTime:   null.StringFrom("12:00"),
+			Weekdays:  rawJson(`["sunday"]`),
+		}
+	}
+	tests := []struct {
+		name    string
+		st      func() *ScheduleTemplate
+		wantErr bool
+		calls   []*gomock.Call
+	}{
+		{
+			"successful",
+			valid,
+			false,
+			[]*gomock.Call{mockDataScheduleTemplate.EXPECT().Create(ctx, gomock.Any()).Return(nil).AnyTimes()},
+		},
+		{
+			"failed - name",
+			func() *ScheduleTemplate { st := valid(); st.Name = null.String{}; return st },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - weekdays",
+			func() *ScheduleTemplate { st := valid(); st.Weekdays = nil; return st },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - unknown weekday",
+			func() *ScheduleTemplate { st := valid(); st.Weekdays = rawJson(`["funday"]`); return st },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - end before start",
+			func() *ScheduleTemplate { st := valid(); st.EndDate = null.StringFrom("2025-10-01"); return st },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - end time before start time",
+			func() *ScheduleTemplate { st := valid(); st.EndTime = null.StringFrom("08:00"); return st },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - excluded dates format",
+			func() *ScheduleTemplate { st := valid(); st.ExcludedDates = rawJson(`["12/25/2025"]`); return st },
+			true,
+			[]*gomock.Call{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DomainScheduleTemplateV1{dataScheduleTemplateV1: mockDataScheduleTemplate}
+			err := m.Post(ctx, tt.st())
+			if !tt.wantErr {
+				assert.Nil(t, err, "DomainScheduleTemplateV1.Post().%s => expected not error; got: %s", tt.name, err)
+			}
+			if tt.wantErr {
+				assert.NotNil(t, err, "DomainScheduleTemplateV1.Post().%s => expected error: got nil", tt.name)
+			}
+		})
+	}
+}
+
+func TestScheduleTemplate_Windows(t *testing.T) {
+	denver, _ := time.LoadLocation("America/Denver")
+	tests := []struct {
+		name      string
+		st        ScheduleTemplate
+		tz        *time.Location
+		wantDates []string
+	}{
+		{
+			"weekly on sunday",
+			ScheduleTemplate{StartDate: null.StringFrom("2025-11-01"), EndDate: null.StringFrom("2025-11-16"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00"), Weekdays: rawJson(`["Sunday"]`)},
+			time.UTC,
+			[]string{"2025-11-02 09:00", "2025-11-09 09:00", "2025-11-16 09:00"},
+		},
+		{
+			"multiple weekdays with excluded date",
+			ScheduleTemplate{StartDate: null.StringFrom("2025-11-02"), EndDate: null.StringFrom("2025-11-09"), StartTime: null.StringFrom("18:30"), EndTime: null.StringFrom("20:00"), Weekdays: rawJson(`["sun", "wed"]`), ExcludedDates: rawJson(`["2025-11-05"]`)},
+			time.UTC,
+			[]string{"2025-11-02 18:30", "2025-11-09 18:30"},
+		},
+		{
+			"no matching days",
+			ScheduleTemplate{StartDate: null.StringFrom("2025-11-03"), EndDate: null.StringFrom("2025-11-04"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00"), Weekdays: rawJson(`["saturday"]`)},
+			time.UTC,
+			[]string{},
+		},
+		{
+			"local times across daylight saving",
+			ScheduleTemplate{StartDate: null.StringFrom("2025-11-01"), EndDate: null.StringFrom("2025-11-02"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00"), Weekdays: rawJson(`["sat", "sun"]`)},
+			denver,
+			[]string{"2025-11-01 15:00", "2025-11-02 16:00"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.st.Name = null.StringFrom("test")
+			windows, err := tt.st.Windows(tt.tz)
+			assert.Nil(t, err, "ScheduleTemplate.Windows().%s => expected not error; got: %s", tt.name, err)
+			got := []string{}
+			for _, w := range windows {
+				got = append(got, w.Start.Format("2006-01-02 15:04"))
+				assert.True(t, w.End.After(w.Start), "ScheduleTemplate.Windows().%s => end should be after start", tt.name)
+			}
+			assert.Equal(t, tt.wantDates, got, "ScheduleTemplate.Windows().%s => unexpected windows", tt.name)
+		})
+	}
+}
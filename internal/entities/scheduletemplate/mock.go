@@ -0,0 +1,106 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package scheduletemplate is a generated GoMock package.
+package scheduletemplate
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataScheduleTemplateV1Adapter is a mock of DataScheduleTemplateV1Adapter interface.
+type MockDataScheduleTemplateV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataScheduleTemplateV1AdapterMockRecorder
+}
+
+// MockDataScheduleTemplateV1AdapterMockRecorder is the mock recorder for MockDataScheduleTemplateV1Adapter.
+type MockDataScheduleTemplateV1AdapterMockRecorder struct {
+	mock *MockDataScheduleTemplateV1Adapter
+}
+
+// NewMockDataScheduleTemplateV1Adapter creates a new mock instance.
+func NewMockDataScheduleTemplateV1Adapter(ctrl *gomock.Controller) *MockDataScheduleTemplateV1Adapter {
+	mock := &MockDataScheduleTemplateV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataScheduleTemplateV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataScheduleTemplateV1Adapter) EXPECT() *MockDataScheduleTemplateV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDataScheduleTemplateV1Adapter) Create(arg0 context.Context, arg1 *ScheduleTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataScheduleTemplateV1AdapterMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataScheduleTemplateV1Adapter)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDataScheduleTemplateV1Adapter) Delete(arg0 context.Context, arg1 *ScheduleTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataScheduleTemplateV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataScheduleTemplateV1Adapter)(nil).Delete), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockDataScheduleTemplateV1Adapter) Read(arg0 context.Context, arg1 *ScheduleTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataScheduleTemplateV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataScheduleTemplateV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataScheduleTemplateV1Adapter) ReadAll(arg0 context.Context, arg1 *[]ScheduleTemplate, arg2 ScheduleTemplateParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataScheduleTemplateV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataScheduleTemplateV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockDataScheduleTemplateV1Adapter) Update(arg0 context.Context, arg1 ScheduleTemplate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataScheduleTemplateV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataScheduleTemplateV1Adapter)(nil).Update), arg0, arg1)
+}
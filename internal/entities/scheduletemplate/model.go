@@ -0,0 +1,45 @@
+package scheduletemplate
+
+import (
+	"encoding/json"
+	"time"
+
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	ScheduleTemplate struct {
+		Id            int              `db:"id" json:"id"`
+		Name          null.String      `db:"name" json:"name"`
+		StartDate     null.String      `db:"start_date" json:"start_date"`
+		EndDate       null.String      `db:"end_date" json:"end_date"`
+		StartTime     null.String      `db:"start_time" json:"start_time"`
+		EndTime       null.String      `db:"end_time" json:"end_time"`
+		Weekdays      *json.RawMessage `db:"weekdays" json:"weekdays"`
+		ExcludedDates *json.RawMessage `db:"excluded_dates" json:"excluded_dates"`
+		SlotDuration  null.Int         `db:"slot_duration" json:"slot_duration"` // in minutes, defaults to config
+		BufferTime    null.Int         `db:"buffer_time" json:"buffer_time"`     // in minutes, defaults to config
+	}
+
+	ScheduleTemplateParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+
+	// Window is one day's worth of bookable time produced by the recurrence rule
+	Window struct {
+		Start time.Time
+		End   time.Time
+	}
+)
+
+const (
+	ScheduleTemplateConst = "schedule_template"
+	layoutDate            = "2006-01-02"
+	layoutTime            = "15:04"
+)
+
+func InitStorageV1() DataScheduleTemplateV1Adapter {
+	return InitSQLV1()
+}
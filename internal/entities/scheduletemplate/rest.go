@@ -0,0 +1,157 @@
+package scheduletemplate
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestScheduleTemplateV1 struct{}
+)
+
+var (
+	restV1   RestScheduleTemplateV1
+	domainV1 *DomainScheduleTemplateV1
+)
+
+func InitializeScheduleTemplateV1() *DomainScheduleTemplateV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainScheduleTemplateV1(storV1)
+	restV1 = *NewRestScheduleTemplateV1()
+	return domainV1
+}
+
+func RegisterScheduleTemplate(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/schedule-template/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/schedule-template/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/schedule-template", Post)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/schedule-template", Patch)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/schedule-template/:id", Delete)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Post(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Post(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Patch(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Patch(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Delete(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Delete(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestScheduleTemplateV1() *RestScheduleTemplateV1 {
+	return &RestScheduleTemplateV1{}
+}
+
+func (h *RestScheduleTemplateV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	scheduleTemplate := &ScheduleTemplate{Id: int(id)}
+	if err := domainV1.Get(ctx, scheduleTemplate); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *scheduleTemplate, nil)
+}
+
+func (h *RestScheduleTemplateV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := ScheduleTemplateParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	scheduleTemplates := &[]ScheduleTemplate{}
+	totalCount, err := domainV1.Search(ctx, scheduleTemplates, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *scheduleTemplates, &totalCount)
+}
+
+func (h *RestScheduleTemplateV1) Post(c echo.Context) error {
+	ctx := context.Background()
+	st := ScheduleTemplate{}
+	if err := c.Bind(&st); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Post(ctx, &st); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, st, nil)
+}
+
+func (h *RestScheduleTemplateV1) Patch(c echo.Context) error {
+	ctx := context.Background()
+	st := ScheduleTemplate{}
+	if err := c.Bind(&st); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Patch(ctx, st); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestScheduleTemplateV1) Delete(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	scheduleTemplate := &ScheduleTemplate{Id: int(id)}
+	if err := domainV1.Delete(ctx, scheduleTemplate); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
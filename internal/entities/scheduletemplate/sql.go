@@ -0,0 +1,150 @@
+package scheduletemplate
+
+import (
+	"context"
+	"fmt"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLScheduleTemplateV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLScheduleTemplateV1 {
+	db := stor.InitStorage()
+	return &SQLScheduleTemplateV1{DB: db}
+}
+
+func (d *SQLScheduleTemplateV1) Read(ctx context.Context, st *ScheduleTemplate) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			start_date,
+			end_date,
+			start_time,
+			end_time,
+			weekdays,
+			excluded_dates,
+			slot_duration,
+			buffer_time
+		FROM schedule_template WHERE id = $1`
+	if errDB := d.DB.Get(st, sqlGet, st.Id); errDB != nil {
+		return ae.DBError("ScheduleTemplate Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLScheduleTemplateV1) ReadAll(ctx context.Context, st *[]ScheduleTemplate, param ScheduleTemplateParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			start_date,
+			end_date,
+			start_time,
+			end_time,
+			weekdays,
+			excluded_dates,
+			slot_duration,
+			buffer_time
+		FROM schedule_template
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(st, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("ScheduleTemplate ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM schedule_template
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("schedule_template ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLScheduleTemplateV1) Create(ctx context.Context, st *ScheduleTemplate) error {
+	count, errCount := d.count()
+	if errCount != nil {
+		return errCount
+	}
+	st.Id = count
+	sqlPost := `
+		INSERT INTO schedule_template (
+			id,
+			name,
+			start_date,
+			end_date,
+			start_time,
+			end_time,
+			weekdays,
+			excluded_dates,
+			slot_duration,
+			buffer_time
+		) VALUES (
+			:id,
+			:name,
+			:start_date,
+			:end_date,
+			:start_time,
+			:end_time,
+			:weekdays,
+			:excluded_dates,
+			:slot_duration,
+			:buffer_time
+		)`
+	_, errDB := d.DB.NamedExec(sqlPost, st)
+	if errDB != nil {
+		return ae.DBError("ScheduleTemplate Post: unable to insert record.", errDB)
+	}
+
+	return nil
+}
+
+func (d *SQLScheduleTemplateV1) Update(ctx context.Context, st ScheduleTemplate) error {
+	sqlPatch := `
+		UPDATE schedule_template SET
+			name = :name,
+			start_date = :start_date,
+			end_date = :end_date,
+			start_time = :start_time,
+			end_time = :end_time,
+			weekdays = :weekdays,
+			excluded_dates = :excluded_dates,
+			slot_duration = :slot_duration,
+			buffer_time = :buffer_time
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, st); errDB != nil {
+		return ae.DBError("ScheduleTemplate Patch: unable to update record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLScheduleTemplateV1) Delete(ctx context.Context, st *ScheduleTemplate) error {
+	sqlDelete := `
+		DELETE FROM schedule_template WHERE id = $1`
+	if _, errDB := d.DB.Exec(sqlDelete, st.Id); errDB != nil {
+		return ae.DBError("ScheduleTemplate Delete: unable to delete record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLScheduleTemplateV1) count() (int, error) {
+	count := 0
+	if errDB := d.DB.Get(&count, "SELECT COALESCE(MAX(id), 0) FROM schedule_template"); errDB != nil {
+		return 0, ae.DBError("ScheduleTemplate count: unable to get count.", errDB)
+	}
+	return count + 1, nil
+}
@@ -0,0 +1,219 @@
+package season
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"gopkg.in/guregu/null.v3"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=season
+type (
+	DataSeasonV1Adapter interface {
+		Read(context.Context, *Season) error
+		ReadAll(context.Context, *[]Season, SeasonParam) (int, error)
+		Create(context.Context, *Season) error
+		Update(context.Context, Season) error
+		Delete(context.Context, *Season) error
+		List(context.Context, *[]Season) error
+		AssignSlots(context.Context, Season, time.Time, time.Time) error
+		Summary(context.Context, *[]SeasonSummary) error
+	}
+
+	DomainSeasonV1 struct {
+		dataSeasonV1 DataSeasonV1Adapter
+		auditWriter  a.AuditAdapter
+	}
+)
+
+func NewDomainSeasonV1(cssnV1 DataSeasonV1Adapter) *DomainSeasonV1 {
+	aw := a.AuditInit()
+	return &DomainSeasonV1{dataSeasonV1: cssnV1, auditWriter: aw}
+}
+
+func (m *DomainSeasonV1) Get(ctx context.Context, ssn *Season) error {
+	if ssn.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	return m.dataSeasonV1.Read(ctx, ssn)
+}
+
+func (m *DomainSeasonV1) Search(ctx context.Context, ssn *[]Season, param SeasonParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("-open_date", map[string]string{"id": "id", "name": "name", "open_date": "open_date", "close_date": "close_date", "bookable_from": "bookable_from", "archived": "archived", "archived_at": "archived_at"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataSeasonV1.ReadAll(ctx, ssn, param)
+}
+
+// Post adds the season and moves the slots already on file within its dates into it
+func (m *DomainSeasonV1) Post(ctx context.Context, ssn *Season) error {
+	if !ssn.Name.Valid {
+		return ae.MissingParamError("Name")
+	}
+	if !ssn.OpenDate.Valid {
+		return ae.MissingParamError("OpenDate")
+	}
+	if !ssn.CloseDate.Valid {
+		return ae.MissingParamError("CloseDate")
+	}
+	if err := ssn.validate(); err != nil {
+		return err
+	}
+	if err := m.checkOverlap(ctx, *ssn); err != nil {
+		return err
+	}
+	ssn.Archived = null.BoolFrom(false)
+	ssn.ArchivedAt = null.Time{}
+	if err := m.dataSeasonV1.Create(ctx, ssn); err != nil {
+		return err
+	}
+	go a.AuditCreate(m.auditWriter, *ssn, SeasonConst, a.KeysToString("id", ssn.Id))
+	return m.assignSlots(ctx, *ssn)
+}
+
+// Patch changes the season, slots are moved in or out to match new dates; an archived season can't be changed
+func (m *DomainSeasonV1) Patch(ctx context.Context, ssnIn Season) error {
+	ssn := &Season{Id: ssnIn.Id}
+	errGet := m.dataSeasonV1.Read(ctx, ssn)
+	if errGet != nil {
+		return errGet
+	}
+	if ssn.Archived.Bool {
+		return ae.SeasonArchivedError(ssn.Name.String)
+	}
+	existingValues := make(map[string]any)
+	// Name
+	if ssnIn.Name.Valid {
+		existingValues["name"] = ssn.Name.String
+		ssn.Name = ssnIn.Name
+	}
+	// OpenDate
+	if ssnIn.OpenDate.Valid {
+		existingValues["open_date"] = ssn.OpenDate.String
+		ssn.OpenDate = ssnIn.OpenDate
+	}
+	// CloseDate
+	if ssnIn.CloseDate.Valid {
+		existingValues["close_date"] = ssn.CloseDate.String
+		ssn.CloseDate = ssnIn.CloseDate
+	}
+	// BookableFrom
+	if ssnIn.BookableFrom.Valid {
+		existingValues["bookable_from"] = ssn.BookableFrom.String
+		ssn.BookableFrom = ssnIn.BookableFrom
+	}
+	if err := ssn.validate(); err != nil {
+		return err
+	}
+	if err := m.checkOverlap(ctx, *ssn); err != nil {
+		return err
+	}
+	if err := m.dataSeasonV1.Update(ctx, *ssn); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *ssn, SeasonConst, a.KeysToString("id", ssn.Id), existingValues)
+	return m.assignSlots(ctx, *ssn)
+}
+
+// Delete removes the season, its slots stay on file without a season; an archived season is kept for reporting
+func (m *DomainSeasonV1) Delete(ctx context.Context, ssn *Season) error {
+	if ssn.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataSeasonV1.Read(ctx, ssn); err != nil {
+		return err
+	}
+	if ssn.Archived.Bool {
+		return ae.SeasonArchivedError(ssn.Name.String)
+	}
+	if err := m.dataSeasonV1.Delete(ctx, ssn); err != nil {
+		return err
+	}
+	go a.AuditDelete(m.auditWriter, *ssn, SeasonConst, a.KeysToString("id", ssn.Id))
+	return nil
+}
+
+// Archive closes out a season once it is over, its slots can no longer be changed, booked or removed
+// but they stay on file for reporting
+func (m *DomainSeasonV1) Archive(ctx context.Context, ssn *Season) error {
+	if ssn.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataSeasonV1.Read(ctx, ssn); err != nil {
+		return err
+	}
+	if ssn.Archived.Bool {
+		return nil
+	}
+	now := time.Now().UTC()
+	if _, end := ssn.Bounds(config.Sch.GetTimezone()); now.Before(end) {
+		return ae.ParseError("Season can't be archived before its CloseDate has passed")
+	}
+	ssn.Archived = null.BoolFrom(true)
+	ssn.ArchivedAt = null.TimeFrom(now)
+	if err := m.dataSeasonV1.Update(ctx, *ssn); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *ssn, SeasonConst, a.KeysToString("id", ssn.Id), map[string]any{"archived": false, "archived_at": ""})
+	return nil
+}
+
+// Summary is the slot and booking totals of every season, newest first
+func (m *DomainSeasonV1) Summary(ctx context.Context, summaries *[]SeasonSummary) error {
+	return m.dataSeasonV1.Summary(ctx, summaries)
+}
+
+// seasons can't share a day, otherwise a slot could belong to either
+func (m *DomainSeasonV1) checkOverlap(ctx context.Context, ssn Season) error {
+	seasons := []Season{}
+	if err := m.dataSeasonV1.List(ctx, &seasons); err != nil {
+		return err
+	}
+	for _, other := range seasons {
+		if other.Id == ssn.Id {
+			continue
+		}
+		if ssn.OpenDate.String <= other.CloseDate.String && other.OpenDate.String <= ssn.CloseDate.String {
+			return ae.SeasonOverlapError(other.Name.String)
+		}
+	}
+	return nil
+}
+
+func (m *DomainSeasonV1) assignSlots(ctx context.Context, ssn Season) error {
+	start, end := ssn.Bounds(config.Sch.GetTimezone())
+	return m.dataSeasonV1.AssignSlots(ctx, ssn, start, end)
+}
+
+func (s Season) validate() error {
+	if len(s.Name.ValueOrZero()) > 100 {
+		return ae.StringLengthError("Name", 100)
+	}
+	openDate, errOpen := time.Parse(layoutDate, s.OpenDate.String)
+	if errOpen != nil {
+		return ae.ParseError("OpenDate not in correct format")
+	}
+	closeDate, errClose := time.Parse(layoutDate, s.CloseDate.String)
+	if errClose != nil {
+		return ae.ParseError("CloseDate not in correct format")
+	}
+	if closeDate.Before(openDate) {
+		return ae.ParseError("CloseDate must not be before OpenDate")
+	}
+	if s.BookableFrom.Valid {
+		bookableFrom, errBookable := time.Parse(layoutDate, s.BookableFrom.String)
+		if errBookable != nil {
+			return ae.ParseError("BookableFrom not in correct format")
+		}
+		if bookableFrom.After(closeDate) {
+			return ae.ParseError("BookableFrom must not be after CloseDate")
+		}
+	}
+	return nil
+}
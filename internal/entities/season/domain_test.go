@@ -0,0 +1,95 @@
+package season
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestSeason_Bookable(t *testing.T) {
+	tz, _ := time.LoadLocation("America/Denver")
+	season := Season{OpenDate: null.StringFrom("2025-12-01"), CloseDate: null.StringFrom("2025-12-31"), BookableFrom: null.StringFrom("2025-11-01")}
+
+	tests := []struct {
+		name   string
+		season Season
+		now    time.Time
+		want   bool
+	}{
+		{"before bookable_from", season, time.Date(2025, 10, 31, 23, 0, 0, 0, tz), false},
+		{"on bookable_from", season, time.Date(2025, 11, 1, 0, 0, 0, 0, tz), true},
+		{"last day", season, time.Date(2025, 12, 31, 23, 59, 0, 0, tz), true},
+		{"after close_date", season, time.Date(2026, 1, 1, 0, 0, 0, 0, tz), false},
+		{"no bookable_from => open_date", Season{OpenDate: season.OpenDate, CloseDate: season.CloseDate}, time.Date(2025, 11, 15, 9, 0, 0, 0, tz), false},
+		{"archived", Season{OpenDate: season.OpenDate, CloseDate: season.CloseDate, Archived: null.BoolFrom(true)}, time.Date(2025, 12, 15, 9, 0, 0, 0, tz), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.season.Bookable(tt.now.UTC(), tz), "Season.Bookable().%s", tt.name)
+		})
+	}
+	// close_date is inclusive in the unit's timezone, the last evening is still in the season after midnight UTC
+	assert.True(t, season.Contains(time.Date(2026, 1, 1, 5, 0, 0, 0, time.UTC), tz))
+	assert.False(t, season.Contains(time.Date(2026, 1, 1, 7, 0, 0, 0, time.UTC), tz))
+}
+
+func TestDomainSeasonV1_Post(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataSeason := NewMockDataSeasonV1Adapter(ctrl)
+
+	existing := []Season{{Id: 1, Name: null.StringFrom("2024"), OpenDate: null.StringFrom("2024-12-01"), CloseDate: null.StringFrom("2024-12-31")}}
+	mockDataSeason.EXPECT().List(ctx, gomock.Any()).SetArg(1, existing).Return(nil).AnyTimes()
+	mockDataSeason.EXPECT().Create(ctx, gomock.Any()).Return(nil).AnyTimes()
+	mockDataSeason.EXPECT().AssignSlots(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	tests := []struct {
+		name    string
+		ssn     *Season
+		wantErr bool
+	}{
+		{"successful", &Season{Name: null.StringFrom("2025"), OpenDate: null.StringFrom("2025-12-01"), CloseDate: null.StringFrom("2025-12-31"), BookableFrom: null.StringFrom("2025-11-01")}, false},
+		{"failed - missing name", &Season{OpenDate: null.StringFrom("2025-12-01"), CloseDate: null.StringFrom("2025-12-31")}, true},
+		{"failed - date format", &Season{Name: null.StringFrom("2025"), OpenDate: null.StringFrom("12/01/2025"), CloseDate: null.StringFrom("2025-12-31")}, true},
+		{"failed - close before open", &Season{Name: null.StringFrom("2025"), OpenDate: null.StringFrom("2025-12-31"), CloseDate: null.StringFrom("2025-12-01")}, true},
+		{"failed - bookable after close", &Season{Name: null.StringFrom("2025"), OpenDate: null.StringFrom("2025-12-01"), CloseDate: null.StringFrom("2025-12-31"), BookableFrom: null.StringFrom("2026-01-01")}, true},
+		{"failed - overlap", &Season{Name: null.StringFrom("2024 again"), OpenDate: null.StringFrom("2024-12-31"), CloseDate: null.StringFrom("2025-01-31")}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DomainSeasonV1{dataSeasonV1: mockDataSeason}
+			err := m.Post(ctx, tt.ssn)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainSeasonV1.Post().%s => expected error: got: %s", tt.name, err)
+		})
+	}
+}
+
+func TestDomainSeasonV1_Archive(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataSeason := NewMockDataSeasonV1Adapter(ctrl)
+
+	now := time.Now().UTC()
+	over := Season{Id: 1, OpenDate: null.StringFrom(now.AddDate(-1, 0, 0).Format(layoutDate)), CloseDate: null.StringFrom(now.AddDate(0, 0, -2).Format(layoutDate))}
+	current := Season{Id: 2, OpenDate: null.StringFrom(now.AddDate(0, 0, -2).Format(layoutDate)), CloseDate: null.StringFrom(now.AddDate(0, 0, 2).Format(layoutDate))}
+	mockDataSeason.EXPECT().Read(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, ssn *Season) error {
+		if ssn.Id == 1 {
+			*ssn = over
+			return nil
+		}
+		*ssn = current
+		return nil
+	}).AnyTimes()
+	mockDataSeason.EXPECT().Update(ctx, gomock.Any()).Return(nil).Times(1)
+
+	m := &DomainSeasonV1{dataSeasonV1: mockDataSeason}
+	archived := &Season{Id: 1}
+	assert.Nil(t, m.Archive(ctx, archived))
+	assert.True(t, archived.Archived.Bool)
+	assert.True(t, archived.ArchivedAt.Valid)
+	assert.NotNil(t, m.Archive(ctx, &Season{Id: 2}), "DomainSeasonV1.Archive() => a season still running can't be archived")
+}
@@ -0,0 +1,149 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package season is a generated GoMock package.
+package season
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataSeasonV1Adapter is a mock of DataSeasonV1Adapter interface.
+type MockDataSeasonV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataSeasonV1AdapterMockRecorder
+}
+
+// MockDataSeasonV1AdapterMockRecorder is the mock recorder for MockDataSeasonV1Adapter.
+type MockDataSeasonV1AdapterMockRecorder struct {
+	mock *MockDataSeasonV1Adapter
+}
+
+// NewMockDataSeasonV1Adapter creates a new mock instance.
+func NewMockDataSeasonV1Adapter(ctrl *gomock.Controller) *MockDataSeasonV1Adapter {
+	mock := &MockDataSeasonV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataSeasonV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataSeasonV1Adapter) EXPECT() *MockDataSeasonV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// AssignSlots mocks base method.
+func (m *MockDataSeasonV1Adapter) AssignSlots(arg0 context.Context, arg1 Season, arg2, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignSlots", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignSlots indicates an expected call of AssignSlots.
+func (mr *MockDataSeasonV1AdapterMockRecorder) AssignSlots(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignSlots", reflect.TypeOf((*MockDataSeasonV1Adapter)(nil).AssignSlots), arg0, arg1, arg2, arg3)
+}
+
+// Create mocks base method.
+func (m *MockDataSeasonV1Adapter) Create(arg0 context.Context, arg1 *Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataSeasonV1AdapterMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataSeasonV1Adapter)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDataSeasonV1Adapter) Delete(arg0 context.Context, arg1 *Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataSeasonV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataSeasonV1Adapter)(nil).Delete), arg0, arg1)
+}
+
+// List mocks base method.
+func (m *MockDataSeasonV1Adapter) List(arg0 context.Context, arg1 *[]Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockDataSeasonV1AdapterMockRecorder) List(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDataSeasonV1Adapter)(nil).List), arg0, arg1)
+}
+
+// Read mocks base method.
+func (m *MockDataSeasonV1Adapter) Read(arg0 context.Context, arg1 *Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataSeasonV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataSeasonV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataSeasonV1Adapter) ReadAll(arg0 context.Context, arg1 *[]Season, arg2 SeasonParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataSeasonV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataSeasonV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// Summary mocks base method.
+func (m *MockDataSeasonV1Adapter) Summary(arg0 context.Context, arg1 *[]SeasonSummary) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Summary", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Summary indicates an expected call of Summary.
+func (mr *MockDataSeasonV1AdapterMockRecorder) Summary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Summary", reflect.TypeOf((*MockDataSeasonV1Adapter)(nil).Summary), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockDataSeasonV1Adapter) Update(arg0 context.Context, arg1 Season) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataSeasonV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataSeasonV1Adapter)(nil).Update), arg0, arg1)
+}
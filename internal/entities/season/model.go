@@ -0,0 +1,99 @@
+package season
+
+import (
+	"time"
+
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	// Season is one year's declarations, slots from open_date through close_date (inclusive) belong to it
+	Season struct {
+		Id           int         `db:"id" json:"id"`
+		Name         null.String `db:"name" json:"name"`
+		OpenDate     null.String `db:"open_date" json:"open_date"`         // YYYY-MM-DD
+		CloseDate    null.String `db:"close_date" json:"close_date"`       // YYYY-MM-DD
+		BookableFrom null.String `db:"bookable_from" json:"bookable_from"` // YYYY-MM-DD, families can book from this day, defaults to open_date
+		Archived     null.Bool   `db:"archived" json:"archived"`
+		ArchivedAt   null.Time   `db:"archived_at" json:"archived_at"`
+	}
+
+	SeasonParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+
+	// SeasonSummary is a season's totals, for comparing one year to the next
+	SeasonSummary struct {
+		SeasonId   int         `db:"season_id" json:"season_id"`
+		Name       null.String `db:"name" json:"name"`
+		OpenDate   null.String `db:"open_date" json:"open_date"`
+		CloseDate  null.String `db:"close_date" json:"close_date"`
+		Archived   null.Bool   `db:"archived" json:"archived"`
+		Slots      int         `db:"slots" json:"slots"`
+		Booked     int         `db:"booked" json:"booked"`
+		Households int         `db:"households" json:"households"` // distinct households linked to a booking
+	}
+)
+
+const (
+	SeasonConst = "season"
+	layoutDate  = "2006-01-02"
+)
+
+func InitStorageV1() DataSeasonV1Adapter {
+	return InitSQLV1()
+}
+
+// Bounds is when the season's slots start and end (exclusive), the dates are days in tz
+func (s Season) Bounds(tz *time.Location) (start, end time.Time) {
+	start = localDay(s.OpenDate.String, tz)
+	end = localDay(s.CloseDate.String, tz).AddDate(0, 0, 1)
+	return start.UTC(), end.UTC()
+}
+
+// Contains is whether t falls inside the season
+func (s Season) Contains(t time.Time, tz *time.Location) bool {
+	start, end := s.Bounds(tz)
+	return !t.Before(start) && t.Before(end)
+}
+
+// Bookable is whether families can book the season at now: not archived, on or after bookable_from and not yet over
+func (s Season) Bookable(now time.Time, tz *time.Location) bool {
+	if s.Archived.Bool {
+		return false
+	}
+	from := s.OpenDate.String
+	if s.BookableFrom.Valid {
+		from = s.BookableFrom.String
+	}
+	_, end := s.Bounds(tz)
+	return !now.Before(localDay(from, tz)) && now.Before(end)
+}
+
+// For is the season t belongs to, false => none of them
+func For(seasons []Season, t time.Time, tz *time.Location) (Season, bool) {
+	for _, s := range seasons {
+		if s.Contains(t, tz) {
+			return s, true
+		}
+	}
+	return Season{}, false
+}
+
+// Open is every season families can book at now, next season's bookable_from can fall before this one closes
+func Open(seasons []Season, now time.Time, tz *time.Location) []Season {
+	open := []Season{}
+	for _, s := range seasons {
+		if s.Bookable(now, tz) {
+			open = append(open, s)
+		}
+	}
+	return open
+}
+
+func localDay(date string, tz *time.Location) time.Time {
+	day, _ := time.ParseInLocation(layoutDate, date, tz)
+	return day
+}
@@ -0,0 +1,202 @@
+package season
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+)
+
+type (
+	RestSeasonV1 struct{}
+)
+
+var (
+	restV1   RestSeasonV1
+	domainV1 *DomainSeasonV1
+)
+
+func InitializeSeasonV1() *DomainSeasonV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainSeasonV1(storV1)
+	restV1 = *NewRestSeasonV1()
+	return domainV1
+}
+
+func RegisterSeason(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/season/summary", Summary)
+	r.RegisterAndAdd(eg, http.MethodGet, "/season/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/season/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/season", Post)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/season", Patch)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/season/:id", Delete)
+	r.RegisterAndAdd(eg, http.MethodPost, "/season/:id/archive", Archive)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Post(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Post(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Patch(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Patch(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Delete(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Delete(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Archive(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Archive(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Summary(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Summary(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestSeasonV1() *RestSeasonV1 {
+	return &RestSeasonV1{}
+}
+
+func (h *RestSeasonV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	season := &Season{Id: int(id)}
+	if err := domainV1.Get(ctx, season); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *season, nil)
+}
+
+func (h *RestSeasonV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := SeasonParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	seasons := &[]Season{}
+	totalCount, err := domainV1.Search(ctx, seasons, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *seasons, &totalCount)
+}
+
+func (h *RestSeasonV1) Post(c echo.Context) error {
+	ctx := context.Background()
+	ssn := Season{}
+	if err := c.Bind(&ssn); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Post(ctx, &ssn); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, ssn, nil)
+}
+
+func (h *RestSeasonV1) Patch(c echo.Context) error {
+	ctx := context.Background()
+	ssn := Season{}
+	if err := c.Bind(&ssn); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Patch(ctx, ssn); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestSeasonV1) Delete(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	season := &Season{Id: int(id)}
+	if err := domainV1.Delete(ctx, season); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestSeasonV1) Archive(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	season := &Season{Id: int(id)}
+	if err := domainV1.Archive(ctx, season); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *season, nil)
+}
+
+func (h *RestSeasonV1) Summary(c echo.Context) error {
+	ctx := context.Background()
+	summaries := &[]SeasonSummary{}
+	if err := domainV1.Summary(ctx, summaries); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	totalCount := len(*summaries)
+	return handler.FormatResponse(c, 200, *summaries, &totalCount)
+}
@@ -0,0 +1,205 @@
+package season
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLSeasonV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLSeasonV1 {
+	db := stor.InitStorage()
+	return &SQLSeasonV1{DB: db}
+}
+
+func (d *SQLSeasonV1) Read(ctx context.Context, ssn *Season) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			open_date,
+			close_date,
+			bookable_from,
+			archived,
+			archived_at
+		FROM season WHERE id = $1`
+	if errDB := d.DB.Get(ssn, sqlGet, ssn.Id); errDB != nil {
+		return ae.DBError("Season Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLSeasonV1) ReadAll(ctx context.Context, ssn *[]Season, param SeasonParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			open_date,
+			close_date,
+			bookable_from,
+			archived,
+			archived_at
+		FROM season
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(ssn, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("Season ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM season
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("season ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLSeasonV1) Create(ctx context.Context, ssn *Season) error {
+	count, errCount := d.count()
+	if errCount != nil {
+		return errCount
+	}
+	ssn.Id = count
+	sqlPost := `
+		INSERT INTO season (
+			id,
+			name,
+			open_date,
+			close_date,
+			bookable_from,
+			archived,
+			archived_at
+		) VALUES (
+			:id,
+			:name,
+			:open_date,
+			:close_date,
+			:bookable_from,
+			:archived,
+			:archived_at
+		)`
+	_, errDB := d.DB.NamedExec(sqlPost, ssn)
+	if errDB != nil {
+		return ae.DBError("Season Post: unable to insert record.", errDB)
+	}
+
+	return nil
+}
+
+func (d *SQLSeasonV1) Update(ctx context.Context, ssn Season) error {
+	sqlPatch := `
+		UPDATE season SET
+			name = :name,
+			open_date = :open_date,
+			close_date = :close_date,
+			bookable_from = :bookable_from,
+			archived = :archived,
+			archived_at = :archived_at
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, ssn); errDB != nil {
+		return ae.DBError("Season Patch: unable to update record.", errDB)
+	}
+	return nil
+}
+
+// the season's slots are kept, without a season
+func (d *SQLSeasonV1) Delete(ctx context.Context, ssn *Season) (err error) {
+	txn := d.DB.MustBegin()
+	defer usql.TxnFinish(txn, &err)
+
+	if _, errDB := txn.Exec("UPDATE td_date SET season_id = NULL WHERE season_id = $1", ssn.Id); errDB != nil {
+		err = ae.DBError("Season Delete: unable to unassign td_date records.", errDB)
+		return
+	}
+	sqlDelete := `
+		DELETE FROM season WHERE id = $1`
+	if _, errDB := txn.Exec(sqlDelete, ssn.Id); errDB != nil {
+		err = ae.DBError("Season Delete: unable to delete record.", errDB)
+	}
+	return
+}
+
+// every season, oldest first
+func (d *SQLSeasonV1) List(ctx context.Context, ssn *[]Season) error {
+	sqlList := `
+		SELECT
+			id,
+			name,
+			open_date,
+			close_date,
+			bookable_from,
+			archived,
+			archived_at
+		FROM season
+		ORDER BY open_date`
+	if errDB := d.DB.Select(ssn, sqlList); errDB != nil {
+		return ae.DBError("Season List: unable to select records.", errDB)
+	}
+	return nil
+}
+
+// moves the slots from start up to end into the season and any of its slots outside of that back out
+func (d *SQLSeasonV1) AssignSlots(ctx context.Context, ssn Season, start, end time.Time) (err error) {
+	txn := d.DB.MustBegin()
+	defer usql.TxnFinish(txn, &err)
+
+	sqlRelease := `
+		UPDATE td_date SET season_id = NULL
+		WHERE season_id = $1 AND (date_value < $2 OR date_value >= $3)`
+	if _, errDB := txn.Exec(sqlRelease, ssn.Id, start, end); errDB != nil {
+		err = ae.DBError("Season AssignSlots: unable to release td_date records.", errDB)
+		return
+	}
+	sqlAssign := `
+		UPDATE td_date SET season_id = $1
+		WHERE season_id IS NULL AND date_value >= $2 AND date_value < $3`
+	if _, errDB := txn.Exec(sqlAssign, ssn.Id, start, end); errDB != nil {
+		err = ae.DBError("Season AssignSlots: unable to assign td_date records.", errDB)
+	}
+	return
+}
+
+func (d *SQLSeasonV1) Summary(ctx context.Context, summaries *[]SeasonSummary) error {
+	sqlSummary := `
+		SELECT
+			s.id AS season_id,
+			s.name,
+			s.open_date,
+			s.close_date,
+			s.archived,
+			COUNT(t.id) AS slots,
+			COUNT(t.confirm) AS booked,
+			COUNT(DISTINCT t.household_id) AS households
+		FROM season s
+		LEFT JOIN td_date t ON t.season_id = s.id
+		GROUP BY s.id, s.name, s.open_date, s.close_date, s.archived
+		ORDER BY s.open_date DESC`
+	if errDB := d.DB.Select(summaries, sqlSummary); errDB != nil {
+		return ae.DBError("Season Summary: unable to select records.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLSeasonV1) count() (int, error) {
+	count := 0
+	if errDB := d.DB.Get(&count, "SELECT COALESCE(MAX(id), 0) FROM season"); errDB != nil {
+		return 0, ae.DBError("Season count: unable to get count.", errDB)
+	}
+	return count + 1, nil
+}
@@ -2,13 +2,29 @@ package tddate
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/blackflagsoftware/tithe-declare/config"
 	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
 	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
-	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+	bod "github.com/blackflagsoftware/tithe-declare/internal/entities/blackoutdate"
+	itv "github.com/blackflagsoftware/tithe-declare/internal/entities/interviewer"
+	st "github.com/blackflagsoftware/tithe-declare/internal/entities/scheduletemplate"
+	ssn "github.com/blackflagsoftware/tithe-declare/internal/entities/season"
 	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/function"
 	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/ics"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/sms"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/validate"
 	"gopkg.in/guregu/null.v3"
 )
 
@@ -20,20 +36,62 @@ type (
 		Create(context.Context, *TdDate) error
 		Update(context.Context, TdDate) error
 		Delete(context.Context, *TdDate) error
-		GetCurrentDays(context.Context, *[]time.Time, TdDateParam) error
-		CheckSetHoldTime(context.Context, time.Time) error
-		Confirm(context.Context, TdDate) error
+		GetCurrentDays(context.Context, *[]TdDate, TdDateParam) error
+		CheckSetHoldTime(context.Context, *TdDate) error
+		Confirm(context.Context, *TdDate) error
+		Exists(context.Context, int, time.Time) (bool, error)
+		ReadByManageToken(context.Context, *TdDate) error
+		Reschedule(context.Context, TdDate, *TdDate) error
+		ReadByHoldToken(context.Context, *TdDate) error
+		ReleaseExpiredHolds(context.Context, time.Time, time.Time, *[]TdDate) error
+		NextHoldExpiry(context.Context, *TdDate) error
+		SetHousehold(context.Context, TdDate) error
+		SetOutcome(context.Context, TdDate) error
+		Stats(context.Context, *StatsAggregate, TdDateParam) error
+		Import(context.Context, []*TdDate) error
+		ChangeRange(context.Context, SlotRange, *[]TdDate, *[]TdDate) error
+	}
+
+	// SlotReleaseListener is told about a slot that opened back up (cancelled, rescheduled away or an expired hold)
+	SlotReleaseListener interface {
+		SlotReleased(context.Context, TdDate)
+	}
+
+	// SlotBookListener is told about a slot that was just confirmed
+	SlotBookListener interface {
+		SlotBooked(context.Context, TdDate)
+	}
+
+	// NoShowListener is told about a booking that was just marked no-show
+	NoShowListener interface {
+		NoShow(context.Context, TdDate)
 	}
 
 	DomainTdDateV1 struct {
-		dataTdDateV1 DataTdDateV1Adapter
-		auditWriter  a.AuditAdapter
+		dataTdDateV1           DataTdDateV1Adapter
+		dataScheduleTemplateV1 st.DataScheduleTemplateV1Adapter
+		dataInterviewerV1      itv.DataInterviewerV1Adapter
+		dataSeasonV1           ssn.DataSeasonV1Adapter
+		dataBlackoutDateV1     bod.DataBlackoutDateV1Adapter
+		auditWriter            a.AuditAdapter
+		emailer                email.Emailer
+		sms                    sms.Notifier
+		holdSweeper            *HoldSweeper
+		releaseListeners       []SlotReleaseListener
+		bookListeners          []SlotBookListener
+		noShowListeners        []NoShowListener
 	}
 )
 
 func NewDomainTdDateV1(ctd_V1 DataTdDateV1Adapter) *DomainTdDateV1 {
 	aw := a.AuditInit()
-	return &DomainTdDateV1{dataTdDateV1: ctd_V1, auditWriter: aw}
+	cstV1 := st.InitStorageV1()
+	citvV1 := itv.InitStorageV1()
+	cssnV1 := ssn.InitStorageV1()
+	cbodV1 := bod.InitStorageV1()
+	em := email.EmailInit()
+	sn := sms.SMSInit()
+	return &DomainTdDateV1{dataTdDateV1: ctd_V1, dataScheduleTemplateV1: cstV1, dataInterviewerV1: citvV1, dataSeasonV1: cssnV1, dataBlackoutDateV1: cbodV1, auditWriter: aw, emailer: em, sms: sn}
 }
 
 func (m *DomainTdDateV1) Get(ctx context.Context, td_ *TdDate) error {
@@ -46,7 +104,7 @@ func (m *DomainTdDateV1) Get(ctx context.Context, td_ *TdDate) error {
 func (m *DomainTdDateV1) Search(ctx context.Context, td_ *[]TdDate, param TdDateParam) (int, error) {
 	// the second argument (map[string]string) is a list of columns to use for filtering
 	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
-	param.Param.CalculateParam("date_value", map[string]string{"id": "id", "date_value": "date_value", "hold": "hold", "confirm": "confirm", "name": "name", "phone": "phone", "email": "email"})
+	param.Param.CalculateParam("date_value", map[string]string{"id": "id", "date_value": "date_value", "end_value": "end_value", "interviewer_id": "interviewer_id", "hold": "hold", "confirm": "confirm", "name": "name", "phone": "phone", "email": "email", "household_id": "household_id", "season_id": "season_id", "outcome": "outcome", "closed_at": "closed_at"})
 	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
 
 	return m.dataTdDateV1.ReadAll(ctx, td_, param)
@@ -56,14 +114,18 @@ func (m *DomainTdDateV1) Post(ctx context.Context, td_ *TdDate) error {
 	if !td_.DateValue.Valid {
 		return ae.MissingParamError("DateValue")
 	}
-	if td_.Name.Valid && len(td_.Name.ValueOrZero()) > 255 {
-		return ae.StringLengthError("Name", 255)
+	if err := validateContact(td_, false); err != nil {
+		return err
 	}
-	if td_.Phone.Valid && len(td_.Phone.ValueOrZero()) > 255 {
-		return ae.StringLengthError("Phone", 255)
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return err
+	}
+	if err := setSeason(seasons, td_); err != nil {
+		return err
 	}
-	if td_.Email.Valid && len(td_.Email.ValueOrZero()) > 100 {
-		return ae.StringLengthError("Email", 100)
+	if err := m.checkBlackout(ctx, td_.DateValue.Time); err != nil {
+		return err
 	}
 	if err := m.dataTdDateV1.Create(ctx, td_); err != nil {
 		return err
@@ -78,57 +140,144 @@ func (m *DomainTdDateV1) Patch(ctx context.Context, td_In TdDate) error {
 	if errGet != nil {
 		return errGet
 	}
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkArchived(seasons, *td_); err != nil {
+		return err
+	}
+	smsOptIn := td_In.SmsOptIn
+	td_In.SmsOptIn = null.Bool{} // checked against the merged record below
+	// an explicit "" clears the value, validateContact trims it to null
+	nameGiven, phoneGiven, emailGiven := td_In.Name.Valid, td_In.Phone.Valid, td_In.Email.Valid
+	if err := validateContact(&td_In, false); err != nil {
+		return err
+	}
+	td_In.SmsOptIn = smsOptIn
 	existingValues := make(map[string]any)
 	// DateValue
 	if td_In.DateValue.Valid {
 		existingValues["date_value"] = td_.DateValue.Time.Format(time.RFC3339)
+		existingValues["season_id"] = td_.SeasonId.Int64
 		td_.DateValue = td_In.DateValue
+		if err := setSeason(seasons, td_); err != nil {
+			return err
+		}
+		if err := m.checkBlackout(ctx, td_.DateValue.Time); err != nil {
+			return err
+		}
+	}
+	// InterviewerId
+	if td_In.InterviewerId > 0 {
+		existingValues["interviewer_id"] = td_.InterviewerId
+		td_.InterviewerId = td_In.InterviewerId
+	}
+	// EndValue
+	if td_In.EndValue.Valid {
+		existingValues["end_value"] = td_.EndValue.Time.Format(time.RFC3339)
+		td_.EndValue = td_In.EndValue
 	}
-	// Hold
+	// Hold, the zero time clears it
+	wasTaken := td_.Hold.Valid || td_.Confirm.Valid
 	if td_In.Hold.Valid {
 		existingValues["hold"] = td_.Hold.Time.Format(time.RFC3339)
-		td_.Hold = td_In.Hold
+		td_.Hold = null.NewTime(td_In.Hold.Time, !td_In.Hold.Time.IsZero())
 	}
-	// Confirm
+	// Confirm, the zero time clears it
 	if td_In.Confirm.Valid {
 		existingValues["confirm"] = td_.Confirm.Time.Format(time.RFC3339)
-		td_.Confirm = td_In.Confirm
+		td_.Confirm = null.NewTime(td_In.Confirm.Time, !td_In.Confirm.Time.IsZero())
 	}
 	// Name
-	if td_In.Name.Valid {
-		if td_In.Name.Valid && len(td_In.Name.ValueOrZero()) > 255 {
-			return ae.StringLengthError("Name", 255)
-		}
+	if nameGiven {
 		existingValues["name"] = td_.Name.String
 		td_.Name = td_In.Name
 	}
 	// Phone
-	if td_In.Phone.Valid {
-		if td_In.Phone.Valid && len(td_In.Phone.ValueOrZero()) > 255 {
-			return ae.StringLengthError("Phone", 255)
-		}
+	if phoneGiven {
 		existingValues["phone"] = td_.Phone.String
 		td_.Phone = td_In.Phone
 	}
 	// Email
-	if td_In.Email.Valid {
-		if td_In.Email.Valid && len(td_In.Email.ValueOrZero()) > 100 {
-			return ae.StringLengthError("Email", 100)
-		}
+	if emailGiven {
 		existingValues["email"] = td_.Email.String
 		td_.Email = td_In.Email
 	}
+	// HouseholdId
+	if td_In.HouseholdId.Valid {
+		existingValues["household_id"] = td_.HouseholdId.Int64
+		td_.HouseholdId = td_In.HouseholdId
+	}
+	// SmsOptIn
+	if td_In.SmsOptIn.Valid {
+		existingValues["sms_opt_in"] = td_.SmsOptIn.Bool
+		td_.SmsOptIn = td_In.SmsOptIn
+	}
+	released := wasTaken && !td_.Hold.Valid && !td_.Confirm.Valid
+	if released {
+		// the family, their link and any pending hold go with the booking
+		for key, value := range map[string]any{"name": td_.Name.String, "phone": td_.Phone.String, "email": td_.Email.String, "household_id": td_.HouseholdId.Int64} {
+			if _, ok := existingValues[key]; !ok {
+				existingValues[key] = value
+			}
+		}
+		td_.Name = null.String{}
+		td_.Phone = null.String{}
+		td_.Email = null.String{}
+		td_.SmsOptIn = null.Bool{}
+		td_.HouseholdId = null.Int{}
+		td_.Outcome = null.String{}
+		td_.OutcomeAt = null.Time{}
+		td_.ManageToken = null.String{}
+		td_.HoldToken = null.String{}
+		td_.ExpiresAt = null.Time{}
+	}
+	if td_.SmsOptIn.Bool && !td_.Phone.Valid {
+		return ae.ValidationError([]ae.FieldError{{Field: "phone", Message: smsPhoneRequired}})
+	}
+	// Outcome
+	newNoShow := false
+	if td_In.Outcome.Valid {
+		if err := validateOutcome("outcome", td_In.Outcome.String); err != nil {
+			return err
+		}
+		if !td_.Confirm.Valid {
+			return ae.ParseError("Outcome can only be set on a confirmed booking")
+		}
+		existingValues["outcome"] = td_.Outcome.String
+		newNoShow = td_In.Outcome.String == OutcomeNoShow && td_.Outcome.String != OutcomeNoShow
+		td_.Outcome = td_In.Outcome
+		td_.OutcomeAt = null.TimeFrom(time.Now().UTC())
+	}
 	if err := m.dataTdDateV1.Update(ctx, *td_); err != nil {
 		return err
 	}
 	go a.AuditPatch(m.auditWriter, *td_, TdDateConst, a.KeysToString("id", td_.Id), existingValues)
+	if newNoShow {
+		m.noShow(ctx, *td_)
+	}
+	if released {
+		m.slotReleased(ctx, *td_)
+	}
 	return nil
 }
 
+// Delete removes the slot, the slots of an archived season are kept for reporting
 func (m *DomainTdDateV1) Delete(ctx context.Context, td_ *TdDate) error {
 	if td_.Id < 1 {
 		return ae.MissingParamError("Id")
 	}
+	if err := m.dataTdDateV1.Read(ctx, td_); err != nil {
+		return err
+	}
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return err
+	}
+	if err := checkArchived(seasons, *td_); err != nil {
+		return err
+	}
 	if err := m.dataTdDateV1.Delete(ctx, td_); err != nil {
 		return err
 	}
@@ -148,129 +297,899 @@ func (m *DomainTdDateV1) CreateBlock(ctx context.Context, block TdDateBlock) err
 	}
 	// new_date should be in YYYY-MM-DD format
 	// start_time and end_time should be in HH:MM format (24 hour clock)
-	// with start_time, increment by 15 minutes until end_time is reached
+	// with start_time, increment by slot_duration + buffer_time until end_time is reached
+	slot, buffer, errSlot := slotLength(block.SlotDuration, block.BufferTime)
+	if errSlot != nil {
+		return errSlot
+	}
+	// the times are wall-clock times in the unit's timezone, slots are stored in UTC
 	layoutDateTime := "2006-01-02 15:04"
+	tz := config.Sch.GetTimezone()
 	startDT := block.NewDate.String + " " + block.StartTime.String
 	endDT := block.NewDate.String + " " + block.EndTime.String
-	startTime, errStart := time.Parse(layoutDateTime, startDT)
+	startTime, errStart := time.ParseInLocation(layoutDateTime, startDT, tz)
 	if errStart != nil {
 		return ae.ParseError("StartTime not in correct format")
 	}
-	endTime, errEnd := time.Parse(layoutDateTime, endDT)
+	endTime, errEnd := time.ParseInLocation(layoutDateTime, endDT, tz)
 	if errEnd != nil {
 		return ae.ParseError("EndTime not in correct format")
 	}
-	t := startTime
-	for {
-		if t.After(endTime) {
-			break
-		}
-		if t.Equal(endTime) {
-			break
-		}
-		td_ := TdDate{DateValue: null.TimeFrom(t)}
-		if err := m.dataTdDateV1.Create(ctx, &td_); err != nil {
+	startTime = startTime.UTC()
+	endTime = endTime.UTC()
+	if err := m.checkBlackout(ctx, startTime); err != nil {
+		return err
+	}
+	interviewerIds, errItv := m.activeInterviewers(ctx, block.InterviewerIds)
+	if errItv != nil {
+		return errItv
+	}
+	_, _, _, err := m.createSlots(ctx, interviewerIds, startTime, endTime, slot, buffer)
+	return err
+}
+
+// ExpandTemplate generates the td_date slots for every day matched by the schedule template
+// slots that already exist are skipped, so expanding the same template more than once is safe; blackout dates are left empty
+func (m *DomainTdDateV1) ExpandTemplate(ctx context.Context, expand *ExpandTemplateRequest) error {
+	if expand.ScheduleTemplateId < 1 {
+		return ae.MissingParamError("ScheduleTemplateId")
+	}
+	template := &st.ScheduleTemplate{Id: expand.ScheduleTemplateId}
+	if err := m.dataScheduleTemplateV1.Read(ctx, template); err != nil {
+		return err
+	}
+	slot, buffer, err := slotLength(template.SlotDuration, template.BufferTime)
+	if err != nil {
+		return err
+	}
+	windows, err := template.Windows(config.Sch.GetTimezone())
+	if err != nil {
+		return err
+	}
+	interviewerIds, err := m.activeInterviewers(ctx, expand.InterviewerIds)
+	if err != nil {
+		return err
+	}
+	for _, window := range windows {
+		created, skipped, blackedOut, err := m.createSlots(ctx, interviewerIds, window.Start, window.End, slot, buffer)
+		expand.Created += created
+		expand.Skipped += skipped
+		expand.BlackedOut += blackedOut
+		if err != nil {
 			return err
 		}
-		go a.AuditCreate(m.auditWriter, td_, TdDateConst, a.KeysToString("id", td_.Id))
-		t = t.Add(15 * time.Minute)
 	}
 	return nil
 }
 
-func (m *DomainTdDateV1) GetCurrentDays(ctx context.Context, dayWithTimes map[string][]string) error {
+// GetCurrentDays fills in the open times by day across all interviewers (a time shows once if anyone is free)
+// along with the open times for each interviewer; once there are seasons only the open season's times are shown
+// and blackout dates are never shown, even if slots were made for them
+func (m *DomainTdDateV1) GetCurrentDays(ctx context.Context, current *CurrentDateTime) error {
+	if current.DayAndTimes == nil {
+		current.DayAndTimes = make(map[string][]string)
+	}
+	tz := config.Sch.GetTimezone()
+	current.Timezone = tz.String()
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return err
+	}
+	blackouts, err := m.blackouts(ctx)
+	if err != nil {
+		return err
+	}
 	param := TdDateParam{
 		Param: h.Param{
 			Search: h.Search{
 				Filters: []h.Filter{
 					{Column: "date_value", Compare: ">", Value: time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")},
 					{Column: "hold", Compare: "NULL", Value: nil},
+					{Column: "confirm", Compare: "NULL", Value: nil},
+					{Column: "closed_at", Compare: "NULL", Value: nil},
 				},
-				Sort: "date_value",
+				Sort: "date_value,interviewer_id",
 			},
 		},
 	}
-	param.Param.CalculateParam("date_value", map[string]string{"id": "id", "date_value": "date_value", "hold": "hold", "confirm": "confirm", "name": "name", "phone": "phone", "email": "email"})
-	dateTimes := []time.Time{}
-	if err := m.dataTdDateV1.GetCurrentDays(ctx, &dateTimes, param); err != nil {
-		return err
-	}
-	for _, day := range dateTimes {
-		// dayOnly := day[:10] // YYYY-MM-DD
-		// hourStr := day[11:13]
-		// hourOnly, err := strconv.Atoi(hourStr)
-		// if err != nil {
-		// 	return ae.ParseError("DateValue not in correct format")
-		// }
-		// minOnly := day[14:16]
-		// amPM := "AM"
-		// if hourOnly > 11 {
-		// 	amPM = "PM"
-		// }
-		// if hourOnly > 12 {
-		// 	hourInt := (int(hourStr[0]-'0') * 10) + int(hourStr[1]-'0') - 12
-		// 	hourStr = "" + string(rune(hourInt/10+'0')) + string(rune(hourInt%10+'0'))
-		// }
-		// dayWithTimes[dayOnly] = append(dayWithTimes[dayOnly], hourStr+":"+minOnly+" "+amPM)
-		dayOnly := day.Format("2006-01-02")
-		dayWithTimes[dayOnly] = append(dayWithTimes[dayOnly], day.Format("03:04 PM"))
+	if len(seasons) > 0 {
+		open := ssn.Open(seasons, time.Now().UTC(), config.Sch.GetTimezone())
+		if len(open) == 0 {
+			return nil
+		}
+		openIds := make([]int, len(open))
+		for i := range open {
+			openIds[i] = open[i].Id
+		}
+		param.Param.Search.Filters = append(param.Param.Search.Filters, h.Filter{Column: "season_id", Compare: "IN", Value: openIds})
+	}
+	param.Param.CalculateParam("date_value", map[string]string{"id": "id", "date_value": "date_value", "end_value": "end_value", "interviewer_id": "interviewer_id", "hold": "hold", "confirm": "confirm", "name": "name", "phone": "phone", "email": "email", "season_id": "season_id", "closed_at": "closed_at"})
+	tdDates := []TdDate{}
+	if err := m.dataTdDateV1.GetCurrentDays(ctx, &tdDates, param); err != nil {
+		return err
+	}
+	byInterviewer := make(map[int]int) // interviewer_id => index in current.Interviewers
+	skipInterviewer := make(map[int]bool)
+	for _, td := range tdDates {
+		if skipInterviewer[td.InterviewerId] {
+			continue
+		}
+		if _, ok := bod.For(blackouts, td.DateValue.Time, tz); ok {
+			continue
+		}
+		idx, ok := byInterviewer[td.InterviewerId]
+		if !ok {
+			availability := InterviewerAvailability{InterviewerId: td.InterviewerId, DayAndTimes: make(map[string][]string)}
+			if td.InterviewerId > 0 {
+				interviewer := &itv.Interviewer{Id: td.InterviewerId}
+				if err := m.dataInterviewerV1.Read(ctx, interviewer); err != nil || !interviewer.Active.Bool {
+					// slots left behind by a removed or inactive interviewer are not offered
+					skipInterviewer[td.InterviewerId] = true
+					continue
+				}
+				availability.Name = interviewer.Name.String
+			}
+			current.Interviewers = append(current.Interviewers, availability)
+			idx = len(current.Interviewers) - 1
+			byInterviewer[td.InterviewerId] = idx
+		}
+		dayOnly := td.LocalDay()
+		display := td.DisplayTime()
+		current.Interviewers[idx].DayAndTimes[dayOnly] = append(current.Interviewers[idx].DayAndTimes[dayOnly], display)
+		if !slices.Contains(current.DayAndTimes[dayOnly], display) {
+			current.DayAndTimes[dayOnly] = append(current.DayAndTimes[dayOnly], display)
+		}
 	}
 	return nil
 }
-func (m *DomainTdDateV1) CheckSetHoldTime(ctx context.Context, checkHold CheckHoldTimeRequest) error {
-	dt, err := formatDateTime(checkHold)
+
+// CheckSetHoldTime holds the time for the requested interviewer or, if none was given, the first free one
+// the interviewer that was held and the hold token are set on checkHold, the hold token is required by Confirm
+func (m *DomainTdDateV1) CheckSetHoldTime(ctx context.Context, checkHold *CheckHoldTimeRequest) error {
+	dt, err := formatDateTime(*checkHold)
 	if err != nil {
 		return err
 	}
-	return m.dataTdDateV1.CheckSetHoldTime(ctx, dt)
+	if err := m.checkBookable(ctx, dt); err != nil {
+		return err
+	}
+	td_ := &TdDate{InterviewerId: checkHold.InterviewerId, DateValue: null.TimeFrom(dt)}
+	if err := m.HoldSlot(ctx, td_, holdTTL()); err != nil {
+		return err
+	}
+	checkHold.InterviewerId = td_.InterviewerId
+	checkHold.HoldToken = td_.HoldToken.String
+	checkHold.ExpiresAt = td_.ExpiresAt
+	return nil
 }
 
 func (m *DomainTdDateV1) Confirm(ctx context.Context, confirm ConfirmRequest) error {
-	dt, err := formatDateTime(confirm.CheckHoldTimeRequest)
-	if err != nil {
+	if confirm.HoldToken == "" {
+		return ae.MissingParamError("HoldToken")
+	}
+	if err := validateContact(&confirm.TdDate, true); err != nil {
 		return err
 	}
-	confirm.DateValue = null.TimeFrom(dt)
+	confirm.TdDate.HoldToken = null.StringFrom(confirm.HoldToken)
 	confirm.Confirm = null.TimeFrom(time.Now().UTC())
-	return m.dataTdDateV1.Confirm(ctx, confirm.TdDate)
+	confirm.ManageToken = null.StringFrom(newManageToken())
+	if err := m.dataTdDateV1.Confirm(ctx, &confirm.TdDate); err != nil {
+		return err
+	}
+	// read back the slot that was booked for the full appointment window
+	booked := &TdDate{ManageToken: confirm.ManageToken}
+	if err := m.dataTdDateV1.ReadByManageToken(ctx, booked); err != nil {
+		return err
+	}
+	if booked.Email.Valid && booked.Email.String != "" {
+		go m.emailer.SendManageLink(ctx, booked.Email.String, booked.Appointment(), booked.ManageToken.String, booked.BookingCalendar())
+	}
+	if booked.SmsOptIn.Bool && booked.Phone.Valid {
+		go m.sms.SendConfirmation(ctx, booked.Phone.String, booked.Appointment())
+	}
+	m.slotBooked(ctx, *booked)
+	return nil
 }
 
-func (m *DomainTdDateV1) CheckHoldConfirm(ctx context.Context) error {
+// MarkAttendance sets the outcome of the bookings on attendance.Date in one go, for the clerk after the day's declarations
+// every id must be one of that day's bookings; the bookings newly marked no-show get a follow-up, see NoShowListener
+func (m *DomainTdDateV1) MarkAttendance(ctx context.Context, attendance *AttendanceRequest) error {
+	tz := config.Sch.GetTimezone()
+	if attendance.Date == "" {
+		attendance.Date = time.Now().In(tz).Format(layoutDate)
+	}
+	day, errDay := time.ParseInLocation(layoutDate, attendance.Date, tz)
+	if errDay != nil {
+		return ae.ParseError("Date not in correct format")
+	}
+	param := TdDateParam{
+		Param: h.Param{
+			Search: h.Search{
+				Filters: []h.Filter{
+					{Column: "date_value", Compare: ">=", Value: day.UTC()},
+					{Column: "date_value", Compare: "<", Value: day.AddDate(0, 0, 1).UTC()},
+					{Column: "confirm", Compare: "NOT NULL", Value: nil},
+				},
+			},
+		},
+	}
+	booked := []TdDate{}
+	if _, err := m.Search(ctx, &booked, param); err != nil {
+		return err
+	}
+	outcomes := make(map[int]string)
+	fields := []ae.FieldError{}
+	for i, o := range attendance.Outcomes {
+		if !slices.ContainsFunc(booked, func(td_ TdDate) bool { return td_.Id == o.Id }) {
+			fields = append(fields, ae.FieldError{Field: fmt.Sprintf("outcomes[%d].id", i), Message: "is not a booking on " + attendance.Date})
+		}
+		if err := validateOutcome(fmt.Sprintf("outcomes[%d].outcome", i), o.Outcome); err != nil {
+			fields = append(fields, err.(ae.ApiError).Fields...)
+		}
+		outcomes[o.Id] = o.Outcome
+	}
+	if attendance.Rest.Valid {
+		if err := validateOutcome("rest", attendance.Rest.String); err != nil {
+			fields = append(fields, err.(ae.ApiError).Fields...)
+		}
+	}
+	if len(fields) > 0 {
+		return ae.ValidationError(fields)
+	}
+	now := time.Now().UTC()
+	for _, td_ := range booked {
+		outcome, ok := outcomes[td_.Id]
+		if !ok {
+			if !attendance.Rest.Valid {
+				continue
+			}
+			outcome = attendance.Rest.String
+		}
+		if td_.Outcome.String == outcome {
+			continue
+		}
+		existingValues := map[string]any{"outcome": td_.Outcome.String}
+		newNoShow := outcome == OutcomeNoShow
+		td_.Outcome = null.StringFrom(outcome)
+		td_.OutcomeAt = null.TimeFrom(now)
+		if err := m.dataTdDateV1.SetOutcome(ctx, td_); err != nil {
+			return err
+		}
+		go a.AuditPatch(m.auditWriter, td_, TdDateConst, a.KeysToString("id", td_.Id), existingValues)
+		attendance.Marked++
+		if newNoShow {
+			attendance.NoShows++
+			m.noShow(ctx, td_)
+		}
+	}
+	return nil
+}
+
+// ChangeRange closes, reopens or deletes the unbooked slots in req's window in one transaction, e.g.: a Sunday cancelled for conference
+// confirmed bookings are never changed, they are reported in req.Booked and with req.NotifyBooked the families are asked to reschedule
+func (m *DomainTdDateV1) ChangeRange(ctx context.Context, req *SlotRangeRequest) error {
+	if !slices.Contains([]string{RangeClose, RangeOpen, RangeDelete}, req.Action) {
+		return ae.ValidationError([]ae.FieldError{{Field: "action", Message: "must be one of: close, open, delete"}})
+	}
+	if req.From == "" {
+		return ae.MissingParamError("From")
+	}
+	tz := config.Sch.GetTimezone()
+	from, errFrom := parseRangeTime(req.From, tz)
+	if errFrom != nil {
+		return ae.ParseError("From not in correct format")
+	}
+	to := time.Date(from.Year(), from.Month(), from.Day()+1, 0, 0, 0, 0, tz) // the end of From's day
+	if req.To != "" {
+		toTime, errTo := parseRangeTime(req.To, tz)
+		if errTo != nil {
+			return ae.ParseError("To not in correct format")
+		}
+		to = toTime
+		if len(req.To) == len(layoutDate) {
+			to = toTime.AddDate(0, 0, 1)
+		}
+	}
+	if !to.After(from) {
+		return ae.ParseError("To must be after From")
+	}
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return err
+	}
+	for _, season := range seasons {
+		start, end := season.Bounds(tz)
+		if season.Archived.Bool && start.Before(to) && from.Before(end) {
+			return ae.SeasonArchivedError(season.Name.String)
+		}
+	}
+	now := time.Now().UTC()
+	changed := []TdDate{}
+	booked := []TdDate{}
+	sr := SlotRange{Action: req.Action, InterviewerId: req.InterviewerId, From: from.UTC(), To: to.UTC(), ClosedAt: now}
+	if err := m.dataTdDateV1.ChangeRange(ctx, sr, &changed, &booked); err != nil {
+		return err
+	}
+	req.Changed = []int{}
+	for _, td_ := range changed {
+		req.Changed = append(req.Changed, td_.Id)
+		switch req.Action {
+		case RangeDelete:
+			go a.AuditDelete(m.auditWriter, td_, TdDateConst, a.KeysToString("id", td_.Id))
+		case RangeClose:
+			existingValues := map[string]any{"closed_at": ""}
+			if td_.Hold.Valid {
+				existingValues["hold"] = td_.Hold.Time.Format(time.RFC3339) // an unconfirmed hold is dropped
+			}
+			td_.ClosedAt = null.TimeFrom(now)
+			td_.Hold = null.Time{}
+			td_.HoldToken = null.String{}
+			td_.ExpiresAt = null.Time{}
+			go a.AuditPatch(m.auditWriter, td_, TdDateConst, a.KeysToString("id", td_.Id), existingValues)
+		case RangeOpen:
+			existingValues := map[string]any{"closed_at": td_.ClosedAt.Time.Format(time.RFC3339)}
+			td_.ClosedAt = null.Time{}
+			go a.AuditPatch(m.auditWriter, td_, TdDateConst, a.KeysToString("id", td_.Id), existingValues)
+			m.slotReleased(ctx, td_)
+		}
+	}
+	req.Booked = booked
+	req.Notified = 0
+	if req.NotifyBooked {
+		for _, td_ := range booked {
+			if !td_.Email.Valid || td_.Email.String == "" {
+				continue
+			}
+			go m.emailer.SendRescheduleRequest(ctx, td_.Email.String, td_.Appointment(), td_.ManageToken.String)
+			req.Notified++
+		}
+	}
+	return nil
+}
+
+// Stats is the dashboard totals for the slots picked by req, storage sums them up by start time
+// and they are folded into days, weekdays and hours here so they land on the right day in the unit's timezone
+func (m *DomainTdDateV1) Stats(ctx context.Context, stats *TdDateStats, req StatsRequest) error {
+	tz := config.Sch.GetTimezone()
+	filters := []h.Filter{}
+	var from, to time.Time
+	if req.From != "" {
+		day, errFrom := time.ParseInLocation(layoutDate, req.From, tz)
+		if errFrom != nil {
+			return ae.ParseError("From not in correct format")
+		}
+		from = day
+		filters = append(filters, h.Filter{Column: "date_value", Compare: ">=", Value: from.UTC()})
+	}
+	if req.To != "" {
+		day, errTo := time.ParseInLocation(layoutDate, req.To, tz)
+		if errTo != nil {
+			return ae.ParseError("To not in correct format")
+		}
+		to = day
+		filters = append(filters, h.Filter{Column: "date_value", Compare: "<", Value: to.AddDate(0, 0, 1).UTC()})
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return ae.ParseError("To must not be before From")
+	}
+	if req.SeasonId > 0 {
+		filters = append(filters, h.Filter{Column: "season_id", Compare: "=", Value: req.SeasonId})
+	}
+	param := TdDateParam{Param: h.Param{Search: h.Search{Filters: filters}}}
+	param.Param.CalculateParam("date_value", map[string]string{"date_value": "t.date_value", "season_id": "t.season_id"})
+	agg := &StatsAggregate{}
+	if err := m.dataTdDateV1.Stats(ctx, agg, param); err != nil {
+		return err
+	}
+
+	*stats = TdDateStats{Days: []DayStats{}, Seasons: []SeasonStats{}, Weekdays: []WeekdayStats{}, Hours: []HourStats{}, LeadTime: agg.LeadTime, Timezone: tz.String()}
+	days := make(map[string]*SlotCounts)
+	weekdays := make(map[time.Weekday]*SlotCounts)
+	hours := make(map[int]*SlotCounts)
+	for _, s := range agg.Starts {
+		start, errStart := time.Parse("2006-01-02 15:04", s.Start)
+		if errStart != nil {
+			continue
+		}
+		local := start.In(tz)
+		stats.SlotCounts.add(s.SlotCounts)
+		addTo(days, local.Format(layoutDate), s.SlotCounts)
+		addTo(weekdays, local.Weekday(), s.SlotCounts)
+		addTo(hours, local.Hour(), s.SlotCounts)
+	}
+	stats.SlotCounts.setFillRate()
+	for _, date := range slices.Sorted(maps.Keys(days)) {
+		days[date].setFillRate()
+		stats.Days = append(stats.Days, DayStats{Date: date, SlotCounts: *days[date]})
+	}
+	for _, weekday := range slices.Sorted(maps.Keys(weekdays)) {
+		weekdays[weekday].setFillRate()
+		stats.Weekdays = append(stats.Weekdays, WeekdayStats{Weekday: weekday.String(), SlotCounts: *weekdays[weekday]})
+	}
+	for _, hour := range slices.Sorted(maps.Keys(hours)) {
+		hours[hour].setFillRate()
+		stats.Hours = append(stats.Hours, HourStats{Hour: hour, SlotCounts: *hours[hour]})
+	}
+	for _, s := range agg.Seasons {
+		s.SlotCounts.setFillRate()
+		stats.Seasons = append(stats.Seasons, s)
+	}
+	return nil
+}
+
+func addTo[K comparable](groups map[K]*SlotCounts, key K, counts SlotCounts) {
+	if _, ok := groups[key]; !ok {
+		groups[key] = &SlotCounts{}
+	}
+	groups[key].add(counts)
+}
+
+// LinkHousehold sets the household the confirmed booking belongs to
+func (m *DomainTdDateV1) LinkHousehold(ctx context.Context, td_ TdDate) error {
+	if td_.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataTdDateV1.SetHousehold(ctx, td_); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, td_, TdDateConst, a.KeysToString("id", td_.Id), map[string]any{"household_id": ""})
+	return nil
+}
+
+// CalendarFeed is every confirmed declaration as an ics calendar for the clerks to subscribe to
+func (m *DomainTdDateV1) CalendarFeed(ctx context.Context) ([]byte, error) {
 	param := TdDateParam{
 		Param: h.Param{
 			Search: h.Search{
 				Filters: []h.Filter{
 					{Column: "hold", Compare: "NOT NULL", Value: nil},
-					{Column: "confirm", Compare: "NULL", Value: nil},
+					{Column: "confirm", Compare: "NOT NULL", Value: nil},
 				},
 			},
 		},
 	}
-	param.Param.CalculateParam("date_value", map[string]string{"id": "id", "date_value": "date_value", "hold": "hold", "confirm": "confirm", "name": "name", "phone": "phone", "email": "email"})
 	tdDates := []TdDate{}
-	if _, err := m.dataTdDateV1.ReadAll(ctx, &tdDates, param); err != nil {
-		return err
+	if _, err := m.Search(ctx, &tdDates, param); err != nil {
+		return nil, err
 	}
-	tenMinsAgo := time.Now().UTC().Add(-10 * time.Minute)
+	cal := ics.Calendar{ProdId: calendarProdId, Name: "Tithing Declarations"}
 	for _, td := range tdDates {
-		if td.Hold.Valid && td.Hold.Time.Before(tenMinsAgo) {
-			td.Hold = null.Time{}
-			if err := m.dataTdDateV1.Update(ctx, td); err != nil {
-				logging.Default.Println("Error releasing hold on td_date id", td.Id, ":", err)
+		description := "Phone: " + td.Phone.String + "\nEmail: " + td.Email.String
+		cal.Events = append(cal.Events, td.CalendarEvent("Tithing Declaration - "+td.Name.String, description))
+	}
+	return cal.Bytes(), nil
+}
+
+// GetByManageToken looks up a confirmed declaration from the token emailed to the family
+func (m *DomainTdDateV1) GetByManageToken(ctx context.Context, td_ *TdDate) error {
+	if !validManageToken(td_.ManageToken.String) {
+		return ae.ManageTokenInvalidError()
+	}
+	return m.dataTdDateV1.ReadByManageToken(ctx, td_)
+}
+
+// CancelByManageToken frees the slot back up for someone else
+func (m *DomainTdDateV1) CancelByManageToken(ctx context.Context, manageToken string) error {
+	td_ := &TdDate{ManageToken: null.StringFrom(manageToken)}
+	if err := m.GetByManageToken(ctx, td_); err != nil {
+		return err
+	}
+	if err := m.checkManageable(ctx, *td_); err != nil {
+		return err
+	}
+	existingValues := map[string]any{
+		"hold":    td_.Hold.Time.Format(time.RFC3339),
+		"confirm": td_.Confirm.Time.Format(time.RFC3339),
+		"name":    td_.Name.String,
+		"phone":   td_.Phone.String,
+		"email":   td_.Email.String,
+	}
+	td_.Hold = null.Time{}
+	td_.Confirm = null.Time{}
+	td_.Name = null.String{}
+	td_.Phone = null.String{}
+	td_.Email = null.String{}
+	td_.SmsOptIn = null.Bool{}
+	td_.HouseholdId = null.Int{}
+	td_.Outcome = null.String{}
+	td_.OutcomeAt = null.Time{}
+	td_.ManageToken = null.String{}
+	td_.HoldToken = null.String{}
+	td_.ExpiresAt = null.Time{}
+	if err := m.dataTdDateV1.Update(ctx, *td_); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *td_, TdDateConst, a.KeysToString("id", td_.Id), existingValues)
+	m.slotReleased(ctx, *td_)
+	return nil
+}
+
+// RescheduleByManageToken moves the declaration to another free slot, the manage token stays the same
+// moveTo.InterviewerId is optional, 0 => any free interviewer; to is filled with the new slot
+func (m *DomainTdDateV1) RescheduleByManageToken(ctx context.Context, manageToken string, moveTo CheckHoldTimeRequest, to *TdDate) error {
+	from := &TdDate{ManageToken: null.StringFrom(manageToken)}
+	if err := m.GetByManageToken(ctx, from); err != nil {
+		return err
+	}
+	if err := m.checkManageable(ctx, *from); err != nil {
+		return err
+	}
+	dt, err := formatDateTime(moveTo)
+	if err != nil {
+		return err
+	}
+	if !dt.After(time.Now().UTC()) {
+		return ae.PastTimeError()
+	}
+	if err := m.checkBookable(ctx, dt); err != nil {
+		return err
+	}
+	to.InterviewerId = moveTo.InterviewerId
+	to.DateValue = null.TimeFrom(dt)
+	to.Hold = null.TimeFrom(time.Now().UTC())
+	if err := m.dataTdDateV1.Reschedule(ctx, *from, to); err != nil {
+		return err
+	}
+	released := *from
+	released.Hold = null.Time{}
+	released.Confirm = null.Time{}
+	released.Name = null.String{}
+	released.Phone = null.String{}
+	released.Email = null.String{}
+	released.SmsOptIn = null.Bool{}
+	released.HouseholdId = null.Int{}
+	released.Outcome = null.String{}
+	released.OutcomeAt = null.Time{}
+	released.ManageToken = null.String{}
+	go a.AuditPatch(m.auditWriter, released, TdDateConst, a.KeysToString("id", from.Id), map[string]any{"hold": from.Hold.Time.Format(time.RFC3339), "confirm": from.Confirm.Time.Format(time.RFC3339), "name": from.Name.String, "phone": from.Phone.String, "email": from.Email.String})
+	go a.AuditPatch(m.auditWriter, *to, TdDateConst, a.KeysToString("id", to.Id), map[string]any{"hold": "", "confirm": "", "name": "", "phone": "", "email": ""})
+	m.slotReleased(ctx, released)
+	return nil
+}
+
+// checkManageable refuses a family's change to a booking that has started, has an outcome or is in an archived season
+// the manage token never expires, an old link must not undo what the clerk has recorded
+func (m *DomainTdDateV1) checkManageable(ctx context.Context, td_ TdDate) error {
+	if td_.Outcome.Valid || !td_.DateValue.Time.After(time.Now().UTC()) {
+		return ae.BookingLockedError()
+	}
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return err
+	}
+	return checkArchived(seasons, td_)
+}
+
+// AddSlotReleaseListener registers a listener for slots that open back up
+func (m *DomainTdDateV1) AddSlotReleaseListener(listener SlotReleaseListener) {
+	m.releaseListeners = append(m.releaseListeners, listener)
+}
+
+// AddSlotBookListener registers a listener for slots that get confirmed
+func (m *DomainTdDateV1) AddSlotBookListener(listener SlotBookListener) {
+	m.bookListeners = append(m.bookListeners, listener)
+}
+
+// AddNoShowListener registers a listener for bookings marked no-show
+func (m *DomainTdDateV1) AddNoShowListener(listener NoShowListener) {
+	m.noShowListeners = append(m.noShowListeners, listener)
+}
+
+// HoldSlot holds the given slot (by date_value and interviewer_id) on behalf of someone else for ttl
+// the hold token and expires_at are set on td_, it is confirmed like any other hold
+func (m *DomainTdDateV1) HoldSlot(ctx context.Context, td_ *TdDate, ttl time.Duration) error {
+	if !td_.DateValue.Valid {
+		return ae.MissingParamError("DateValue")
+	}
+	now := time.Now().UTC()
+	td_.Hold = null.TimeFrom(now)
+	td_.HoldToken = null.StringFrom(function.GenerateUUID())
+	td_.ExpiresAt = null.TimeFrom(now.Add(ttl))
+	if err := m.dataTdDateV1.CheckSetHoldTime(ctx, td_); err != nil {
+		return err
+	}
+	if m.holdSweeper != nil {
+		m.holdSweeper.Wake()
+	}
+	return nil
+}
+
+// ReleaseExpiredHolds frees every unconfirmed hold that is past its expires_at and returns when the next hold expires
+// a zero time means nothing is currently held
+func (m *DomainTdDateV1) ReleaseExpiredHolds(ctx context.Context) (time.Time, error) {
+	now := time.Now().UTC()
+	// holds placed before expires_at existed fall back to hold + ttl
+	heldBefore := now.Add(-holdTTL())
+	released := []TdDate{}
+	if err := m.dataTdDateV1.ReleaseExpiredHolds(ctx, now, heldBefore, &released); err != nil {
+		return time.Time{}, err
+	}
+	for _, td_ := range released {
+		m.slotReleased(ctx, td_)
+	}
+	next := &TdDate{}
+	if err := m.dataTdDateV1.NextHoldExpiry(ctx, next); err != nil {
+		return time.Time{}, err
+	}
+	return next.ExpiresAt.Time, nil
+}
+
+// GetHoldRemaining reports how long is left on the hold placed with the hold token
+func (m *DomainTdDateV1) GetHoldRemaining(ctx context.Context, remaining *HoldRemaining) error {
+	if remaining.HoldToken == "" {
+		return ae.MissingParamError("HoldToken")
+	}
+	td_ := &TdDate{HoldToken: null.StringFrom(remaining.HoldToken)}
+	if err := m.dataTdDateV1.ReadByHoldToken(ctx, td_); err != nil {
+		return err
+	}
+	remaining.ExpiresAt = td_.ExpiresAt
+	seconds := int(time.Until(td_.ExpiresAt.Time).Seconds())
+	if seconds < 0 {
+		seconds = 0
+	}
+	remaining.RemainingSeconds = seconds
+	return nil
+}
+
+// with start, increment by slot + buffer while a whole slot still fits before end; any slot already on file is left alone
+// and no slot is made on a blackout date; each interviewer gets their own slot at every time
+func (m *DomainTdDateV1) createSlots(ctx context.Context, interviewerIds []int, start, end time.Time, slot, buffer time.Duration) (created, skipped, blackedOut int, err error) {
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return
+	}
+	blackouts, err := m.blackouts(ctx)
+	if err != nil {
+		return
+	}
+	tz := config.Sch.GetTimezone()
+	for t := start; !t.Add(slot).After(end); t = t.Add(slot + buffer) {
+		if _, ok := bod.For(blackouts, t, tz); ok {
+			blackedOut += len(interviewerIds)
+			continue
+		}
+		for _, interviewerId := range interviewerIds {
+			exists, errExists := m.dataTdDateV1.Exists(ctx, interviewerId, t)
+			if errExists != nil {
+				err = errExists
+				return
+			}
+			if exists {
+				skipped++
+				continue
 			}
+			td_ := TdDate{InterviewerId: interviewerId, DateValue: null.TimeFrom(t), EndValue: null.TimeFrom(t.Add(slot))}
+			if err = setSeason(seasons, &td_); err != nil {
+				return
+			}
+			if err = m.dataTdDateV1.Create(ctx, &td_); err != nil {
+				return
+			}
+			go a.AuditCreate(m.auditWriter, td_, TdDateConst, a.KeysToString("id", td_.Id))
+			created++
+		}
+	}
+	return
+}
+
+// activeInterviewers verifies each interviewer is on file and active, no interviewers => the unassigned interviewer (0)
+func (m *DomainTdDateV1) activeInterviewers(ctx context.Context, interviewerIds []int) ([]int, error) {
+	if len(interviewerIds) == 0 {
+		return []int{0}, nil
+	}
+	ids := []int{}
+	for _, id := range interviewerIds {
+		if slices.Contains(ids, id) {
+			continue
+		}
+		interviewer := &itv.Interviewer{Id: id}
+		if err := m.dataInterviewerV1.Read(ctx, interviewer); err != nil {
+			return nil, err
+		}
+		if !interviewer.Active.Bool {
+			return nil, ae.ParseError("Interviewer is not active: " + interviewer.Name.String)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// seasons is every season on file, see season.For and season.Open
+func (m *DomainTdDateV1) seasons(ctx context.Context) ([]ssn.Season, error) {
+	seasons := []ssn.Season{}
+	if err := m.dataSeasonV1.List(ctx, &seasons); err != nil {
+		return nil, err
+	}
+	return seasons, nil
+}
+
+// checkBookable is whether families can book dt, never on a blackout date; otherwise anything goes until the first season is added
+func (m *DomainTdDateV1) checkBookable(ctx context.Context, dt time.Time) error {
+	if err := m.checkBlackout(ctx, dt); err != nil {
+		return err
+	}
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return err
+	}
+	if len(seasons) == 0 {
+		return nil
+	}
+	tz := config.Sch.GetTimezone()
+	if season, ok := ssn.For(seasons, dt, tz); ok && season.Bookable(time.Now().UTC(), tz) {
+		return nil
+	}
+	return ae.SeasonClosedError()
+}
+
+func (m *DomainTdDateV1) blackouts(ctx context.Context) ([]bod.BlackoutDate, error) {
+	blackouts := []bod.BlackoutDate{}
+	if err := m.dataBlackoutDateV1.List(ctx, &blackouts); err != nil {
+		return nil, err
+	}
+	return blackouts, nil
+}
+
+// checkBlackout refuses dt if it falls on a blackout date
+func (m *DomainTdDateV1) checkBlackout(ctx context.Context, dt time.Time) error {
+	blackouts, err := m.blackouts(ctx)
+	if err != nil {
+		return err
+	}
+	if blackout, ok := bod.For(blackouts, dt, config.Sch.GetTimezone()); ok {
+		return ae.BlackoutDateError(blackout.Name.String)
+	}
+	return nil
+}
+
+// setSeason puts the slot in the season its date_value falls in (if any), an archived season can't be added to
+func setSeason(seasons []ssn.Season, td_ *TdDate) error {
+	season, ok := ssn.For(seasons, td_.DateValue.Time, config.Sch.GetTimezone())
+	if !ok {
+		td_.SeasonId = null.Int{}
+		return nil
+	}
+	if season.Archived.Bool {
+		return ae.SeasonArchivedError(season.Name.String)
+	}
+	td_.SeasonId = null.IntFrom(int64(season.Id))
+	return nil
+}
+
+// checkArchived refuses changes to a slot in an archived season
+func checkArchived(seasons []ssn.Season, td_ TdDate) error {
+	if !td_.SeasonId.Valid {
+		return nil
+	}
+	for _, season := range seasons {
+		if season.Id == int(td_.SeasonId.Int64) && season.Archived.Bool {
+			return ae.SeasonArchivedError(season.Name.String)
 		}
 	}
 	return nil
 }
 
+// listeners run in the background, a slow listener should not hold up a cancel or the sweeper
+func (m *DomainTdDateV1) slotReleased(ctx context.Context, td_ TdDate) {
+	for _, listener := range m.releaseListeners {
+		go listener.SlotReleased(ctx, td_)
+	}
+}
+
+func (m *DomainTdDateV1) slotBooked(ctx context.Context, td_ TdDate) {
+	for _, listener := range m.bookListeners {
+		go listener.SlotBooked(ctx, td_)
+	}
+}
+
+type quietKey struct{}
+
+// WithoutMessages marks ctx so the listeners leave out any emails or texts, i.e.: bookings imported from the old sign-up sheet
+func WithoutMessages(ctx context.Context) context.Context {
+	return context.WithValue(ctx, quietKey{}, true)
+}
+
+// Quiet is true for a ctx from WithoutMessages
+func Quiet(ctx context.Context) bool {
+	quiet, _ := ctx.Value(quietKey{}).(bool)
+	return quiet
+}
+
+func (m *DomainTdDateV1) noShow(ctx context.Context, td_ TdDate) {
+	for _, listener := range m.noShowListeners {
+		go listener.NoShow(ctx, td_)
+	}
+}
+
+// parseRangeTime reads YYYY-MM-DD HH:MM or a day alone (its start) in tz
+func parseRangeTime(value string, tz *time.Location) (time.Time, error) {
+	if len(value) == len(layoutDate) {
+		return time.ParseInLocation(layoutDate, value, tz)
+	}
+	return time.ParseInLocation("2006-01-02 15:04", value, tz)
+}
+
+func holdTTL() time.Duration {
+	return time.Duration(config.Sch.GetHoldTTL()) * time.Minute
+}
+
+// slotLength resolves the slot duration and buffer (in minutes), falling back to the configured defaults
+func slotLength(duration, buffer null.Int) (time.Duration, time.Duration, error) {
+	slotMins := int64(config.Sch.GetSlotDuration())
+	if duration.Valid {
+		slotMins = duration.Int64
+	}
+	bufferMins := int64(config.Sch.GetSlotBuffer())
+	if buffer.Valid {
+		bufferMins = buffer.Int64
+	}
+	if slotMins < 1 {
+		return 0, 0, ae.ParseError("SlotDuration must be at least 1 minute")
+	}
+	if bufferMins < 0 {
+		return 0, 0, ae.ParseError("BufferTime must not be negative")
+	}
+	return time.Duration(slotMins) * time.Minute, time.Duration(bufferMins) * time.Minute, nil
+}
+
 func formatDateTime(checkHold CheckHoldTimeRequest) (time.Time, error) {
 	// date should be in YYYY-MM-DD format
-	// time should be in HH:MM AM/PM format
-	layoutDateTime := "2006-01-02 03:04 PM"
-	dateTime := checkHold.Date + " " + checkHold.Time
-	dt, errParse := time.Parse(layoutDateTime, dateTime)
+	// time should be in HH:MM AM/PM format, a full window (HH:MM AM/PM - HH:MM AM/PM) as shown by GetCurrentDays is also accepted
+	// both are in the unit's timezone, the returned time is UTC to match what is stored
+	layoutDateTime := layoutDate + " " + layoutDisplayTime
+	startTime, _, _ := strings.Cut(checkHold.Time, " - ")
+	dateTime := checkHold.Date + " " + strings.TrimSpace(startTime)
+	dt, errParse := time.ParseInLocation(layoutDateTime, dateTime, config.Sch.GetTimezone())
 	if errParse != nil {
 		return time.Time{}, ae.ParseError("Date or Time not in correct format")
 	}
-	return dt, nil
+	return dt.UTC(), nil
+}
+
+// a manage token is a random value and its signature (value.signature), made up or altered tokens are rejected before looking anything up
+func newManageToken() string {
+	value := function.GenerateRandomString(32)
+	return value + "." + signManageValue(value)
+}
+
+func signManageValue(value string) string {
+	mac := hmac.New(sha256.New, []byte(config.Sch.GetManageSecret()))
+	mac.Write([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func validManageToken(manageToken string) bool {
+	value, signature, found := strings.Cut(manageToken, ".")
+	if !found || value == "" {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(signManageValue(value)))
+}
+
+// validateContact trims the name, email and phone and checks them all at once, the phone is normalized to E.164
+// a booking requires the name, opting in to texts requires the phone
+func validateContact(td_ *TdDate, requireName bool) error {
+	v := &validate.Validator{}
+	if requireName {
+		v.Required("name", &td_.Name)
+	} else {
+		validate.Trim(&td_.Name)
+	}
+	v.MaxLength("name", td_.Name, 255)
+	v.Email("email", &td_.Email)
+	v.MaxLength("email", td_.Email, 100)
+	v.Phone("phone", &td_.Phone, config.S.DefaultCountry)
+	v.MaxLength("phone", td_.Phone, 255)
+	if td_.SmsOptIn.Bool && !td_.Phone.Valid {
+		v.Add("phone", smsPhoneRequired)
+	}
+	return v.Err()
+}
+
+func validateOutcome(field, outcome string) error {
+	if !slices.Contains([]string{OutcomeAttended, OutcomeNoShow, OutcomeRescheduled}, outcome) {
+		return ae.ValidationError([]ae.FieldError{{Field: field, Message: "must be one of: attended, no-show, rescheduled"}})
+	}
+	return nil
 }
@@ -0,0 +1,939 @@
+package tddate
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	bod "github.com/blackflagsoftware/tithe-declare/internal/entities/blackoutdate"
+	itv "github.com/blackflagsoftware/tithe-declare/internal/entities/interviewer"
+	st "github.com/blackflagsoftware/tithe-declare/internal/entities/scheduletemplate"
+	ssn "github.com/blackflagsoftware/tithe-declare/internal/entities/season"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/sms"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDomainTdDateV1_CreateBlock(t *testing.T) {
+	ctx := context.TODO()
+
+	tests := []struct {
+		name      string
+		block     TdDateBlock
+		wantErr   bool
+		wantSlots []string
+	}{
+		{
+			"successful - default length",
+			TdDateBlock{NewDate: null.StringFrom("2025-11-02"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00")},
+			false,
+			[]string{"09:00 AM - 09:15 AM", "09:15 AM - 09:30 AM", "09:30 AM - 09:45 AM", "09:45 AM - 10:00 AM"},
+		},
+		{
+			"successful - slot and buffer",
+			TdDateBlock{NewDate: null.StringFrom("2025-11-02"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00"), SlotDuration: null.IntFrom(20), BufferTime: null.IntFrom(5)},
+			false,
+			[]string{"09:00 AM - 09:20 AM", "09:25 AM - 09:45 AM"},
+		},
+		{
+			"successful - one slot per interviewer",
+			TdDateBlock{NewDate: null.StringFrom("2025-11-02"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("09:30"), InterviewerIds: []int{1, 2}},
+			false,
+			[]string{"1: 09:00 AM - 09:15 AM", "2: 09:00 AM - 09:15 AM", "1: 09:15 AM - 09:30 AM", "2: 09:15 AM - 09:30 AM"},
+		},
+		{
+			"failed - inactive interviewer",
+			TdDateBlock{NewDate: null.StringFrom("2025-11-02"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00"), InterviewerIds: []int{3}},
+			true,
+			nil,
+		},
+		{
+			"failed - blackout date",
+			TdDateBlock{NewDate: null.StringFrom("2025-11-09"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00")},
+			true,
+			nil,
+		},
+		{
+			"failed - missing date",
+			TdDateBlock{StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00")},
+			true,
+			nil,
+		},
+		{
+			"failed - zero slot duration",
+			TdDateBlock{NewDate: null.StringFrom("2025-11-02"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00"), SlotDuration: null.IntFrom(0)},
+			true,
+			nil,
+		},
+		{
+			"failed - negative buffer",
+			TdDateBlock{NewDate: null.StringFrom("2025-11-02"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00"), BufferTime: null.IntFrom(-5)},
+			true,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+			mockDataInterviewer := itv.NewMockDataInterviewerV1Adapter(ctrl)
+			mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+			mockDataSeason.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+			mockDataBlackoutDate := bod.NewMockDataBlackoutDateV1Adapter(ctrl)
+			mockDataBlackoutDate.EXPECT().List(ctx, gomock.Any()).SetArg(1, []bod.BlackoutDate{{Id: 1, Name: null.StringFrom("Stake Conference"), StartDate: null.StringFrom("2025-11-09"), EndDate: null.StringFrom("2025-11-09")}}).Return(nil).AnyTimes()
+			created := []string{}
+			mockDataTdDate.EXPECT().Exists(ctx, gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+			mockDataTdDate.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, td *TdDate) error {
+				if len(tt.block.InterviewerIds) > 0 {
+					created = append(created, strconv.Itoa(td.InterviewerId)+": "+td.DisplayTime())
+					return nil
+				}
+				created = append(created, td.DisplayTime())
+				return nil
+			}).AnyTimes()
+			mockDataInterviewer.EXPECT().Read(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, interviewer *itv.Interviewer) error {
+				// interviewer 3 is inactive
+				interviewer.Active = null.BoolFrom(interviewer.Id != 3)
+				return nil
+			}).AnyTimes()
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataInterviewerV1: mockDataInterviewer, dataSeasonV1: mockDataSeason, dataBlackoutDateV1: mockDataBlackoutDate}
+			err := m.CreateBlock(ctx, tt.block)
+			if !tt.wantErr {
+				assert.Nil(t, err, "DomainTdDateV1.CreateBlock().%s => expected not error; got: %s", tt.name, err)
+				assert.Equal(t, tt.wantSlots, created, "DomainTdDateV1.CreateBlock().%s => unexpected slots", tt.name)
+			}
+			if tt.wantErr {
+				assert.NotNil(t, err, "DomainTdDateV1.CreateBlock().%s => expected error: got nil", tt.name)
+			}
+		})
+	}
+}
+
+func TestManageToken(t *testing.T) {
+	token := newManageToken()
+	value, signature, _ := strings.Cut(token, ".")
+	alter := func(s string) string {
+		if s[0] == 'a' {
+			return "b" + s[1:]
+		}
+		return "a" + s[1:]
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		wantValid bool
+	}{
+		{"successful", token, true},
+		{"failed - altered value", alter(value) + "." + signature, false},
+		{"failed - altered signature", value + "." + alter(signature), false},
+		{"failed - missing signature", value, false},
+		{"failed - empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantValid, validManageToken(tt.token), "validManageToken().%s => unexpected result", tt.name)
+		})
+	}
+}
+
+func TestDomainTdDateV1_ManageByToken(t *testing.T) {
+	ctx := context.TODO()
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "UTC"
+
+	now := time.Now().UTC()
+	tomorrow := now.AddDate(0, 0, 1).Truncate(time.Hour)
+	yesterday := now.AddDate(0, 0, -1).Truncate(time.Hour)
+	archived := ssn.Season{Id: 1, Name: null.StringFrom("Last year"), OpenDate: null.StringFrom(now.AddDate(-1, 0, -7).Format("2006-01-02")), CloseDate: null.StringFrom(now.AddDate(-1, 0, 7).Format("2006-01-02")), Archived: null.BoolFrom(true)}
+	open := ssn.Season{Id: 2, Name: null.StringFrom("This year"), OpenDate: null.StringFrom(now.AddDate(0, 0, -7).Format("2006-01-02")), CloseDate: null.StringFrom(now.AddDate(0, 0, 7).Format("2006-01-02"))}
+	booking := TdDate{Id: 4, DateValue: null.TimeFrom(tomorrow), Hold: null.TimeFrom(now), Confirm: null.TimeFrom(now), Name: null.StringFrom("Smith Family"), ManageToken: null.StringFrom(newManageToken())}
+	moveTo := CheckHoldTimeRequest{Date: now.AddDate(0, 0, 2).Format("2006-01-02"), Time: "09:00 AM"}
+	pastMoveTo := CheckHoldTimeRequest{Date: yesterday.Format("2006-01-02"), Time: "09:00 AM"}
+
+	tests := []struct {
+		name       string
+		booked     TdDate
+		reschedule bool
+		moveTo     CheckHoldTimeRequest
+		wantErr    bool
+	}{
+		{"cancel - successful", booking, false, moveTo, false},
+		{"cancel - failed already started", func() TdDate { b := booking; b.DateValue = null.TimeFrom(yesterday); return b }(), false, moveTo, true},
+		{"cancel - failed outcome set", func() TdDate { b := booking; b.Outcome = null.StringFrom(OutcomeAttended); return b }(), false, moveTo, true},
+		{"cancel - failed archived season", func() TdDate { b := booking; b.SeasonId = null.IntFrom(1); return b }(), false, moveTo, true},
+		{"reschedule - successful", booking, true, moveTo, false},
+		{"reschedule - failed already started", func() TdDate { b := booking; b.DateValue = null.TimeFrom(yesterday); return b }(), true, moveTo, true},
+		{"reschedule - failed outcome set", func() TdDate { b := booking; b.Outcome = null.StringFrom(OutcomeNoShow); return b }(), true, moveTo, true},
+		{"reschedule - failed archived season", func() TdDate { b := booking; b.SeasonId = null.IntFrom(1); return b }(), true, moveTo, true},
+		{"reschedule - failed time in the past", booking, true, pastMoveTo, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+			mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+			mockDataBlackoutDate := bod.NewMockDataBlackoutDateV1Adapter(ctrl)
+			mockDataSeason.EXPECT().List(ctx, gomock.Any()).SetArg(1, []ssn.Season{archived, open}).Return(nil).AnyTimes()
+			mockDataBlackoutDate.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+			mockDataTdDate.EXPECT().ReadByManageToken(ctx, gomock.Any()).SetArg(1, tt.booked).Return(nil)
+			changes := 0
+			if tt.reschedule {
+				mockDataTdDate.EXPECT().Reschedule(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, TdDate, *TdDate) error {
+					changes++
+					return nil
+				}).AnyTimes()
+			} else {
+				mockDataTdDate.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(context.Context, TdDate) error {
+					changes++
+					return nil
+				}).AnyTimes()
+			}
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataSeasonV1: mockDataSeason, dataBlackoutDateV1: mockDataBlackoutDate}
+			var err error
+			if tt.reschedule {
+				err = m.RescheduleByManageToken(ctx, booking.ManageToken.String, tt.moveTo, &TdDate{})
+			} else {
+				err = m.CancelByManageToken(ctx, booking.ManageToken.String)
+			}
+			assert.Equal(t, tt.wantErr, err != nil, "DomainTdDateV1.%s => expected error: got: %s", tt.name, err)
+			if tt.wantErr {
+				assert.Equal(t, 0, changes, "DomainTdDateV1.%s => booking was changed", tt.name)
+			}
+		})
+	}
+}
+
+type bookListenerFunc func(context.Context, TdDate)
+
+func (f bookListenerFunc) SlotBooked(ctx context.Context, td_ TdDate) { f(ctx, td_) }
+
+func TestDomainTdDateV1_Confirm(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+	mockEmailer := email.NewMockEmailer(ctrl)
+	mockSms := sms.NewMockNotifier(ctrl)
+	defer func(country string) { config.S.DefaultCountry = country }(config.S.DefaultCountry)
+	config.S.DefaultCountry = "1"
+
+	start := time.Date(2025, 12, 7, 16, 0, 0, 0, time.UTC)
+	var saved TdDate
+	confirmed := func(_ context.Context, td_ *TdDate) error {
+		saved = *td_
+		return nil
+	}
+	booked := func(_ context.Context, td_ *TdDate) error {
+		*td_ = saved
+		td_.Id = 4
+		td_.DateValue = null.TimeFrom(start)
+		return nil
+	}
+
+	tests := []struct {
+		name       string
+		holdToken  string
+		bookName   string
+		phone      null.String
+		smsOptIn   bool
+		wantErr    bool
+		wantBooked bool
+		wantPhone  string
+		calls      func()
+	}{
+		{
+			"successful",
+			"hold-token",
+			" Smith Family ",
+			null.String{},
+			false,
+			false,
+			true,
+			"",
+			func() {
+				mockDataTdDate.EXPECT().Confirm(ctx, gomock.Any()).DoAndReturn(confirmed)
+				mockDataTdDate.EXPECT().ReadByManageToken(ctx, gomock.Any()).DoAndReturn(booked)
+				mockEmailer.EXPECT().SendManageLink(ctx, "smith@example.com", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			},
+		},
+		{
+			"successful - sms opt in",
+			"hold-token",
+			" Smith Family ",
+			null.StringFrom("(801) 555-0123"),
+			true,
+			false,
+			true,
+			"+18015550123",
+			func() {
+				mockDataTdDate.EXPECT().Confirm(ctx, gomock.Any()).DoAndReturn(confirmed)
+				mockDataTdDate.EXPECT().ReadByManageToken(ctx, gomock.Any()).DoAndReturn(booked)
+				mockEmailer.EXPECT().SendManageLink(ctx, "smith@example.com", gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+				mockSms.EXPECT().SendConfirmation(ctx, "+18015550123", gomock.Any()).Return(nil).AnyTimes()
+			},
+		},
+		{
+			"failed - hold token",
+			"",
+			" Smith Family ",
+			null.String{},
+			false,
+			true,
+			false,
+			"",
+			func() {},
+		},
+		{
+			"failed - name",
+			"hold-token",
+			"",
+			null.String{},
+			false,
+			true,
+			false,
+			"",
+			func() {},
+		},
+		{
+			"failed - phone",
+			"hold-token",
+			" Smith Family ",
+			null.StringFrom("555-0123"),
+			false,
+			true,
+			false,
+			"",
+			func() {},
+		},
+		{
+			"failed - sms opt in without phone",
+			"hold-token",
+			" Smith Family ",
+			null.String{},
+			true,
+			true,
+			false,
+			"",
+			func() {},
+		},
+		{
+			"failed - hold expired",
+			"hold-token",
+			" Smith Family ",
+			null.String{},
+			false,
+			true,
+			false,
+			"",
+			func() {
+				mockDataTdDate.EXPECT().Confirm(ctx, gomock.Any()).Return(ae.HoldTokenInvalidError())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.calls()
+			notified := make(chan TdDate, 1)
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, emailer: mockEmailer, sms: mockSms}
+			m.AddSlotBookListener(bookListenerFunc(func(_ context.Context, td_ TdDate) { notified <- td_ }))
+			confirm := ConfirmRequest{TdDate: TdDate{Name: null.StringFrom(tt.bookName), Email: null.StringFrom("smith@example.com"), Phone: tt.phone, SmsOptIn: null.BoolFrom(tt.smsOptIn)}, HoldToken: tt.holdToken}
+			err := m.Confirm(ctx, confirm)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainTdDateV1.Confirm().%s => expected error: got: %s", tt.name, err)
+			if tt.wantBooked {
+				select {
+				case td_ := <-notified:
+					assert.Equal(t, 4, td_.Id, "DomainTdDateV1.Confirm().%s => unexpected booked slot", tt.name)
+					assert.Equal(t, tt.wantPhone, td_.Phone.String, "DomainTdDateV1.Confirm().%s => phone not normalized", tt.name)
+					assert.Equal(t, "Smith Family", td_.Name.String, "DomainTdDateV1.Confirm().%s => name not trimmed", tt.name)
+				case <-time.After(time.Second):
+					t.Errorf("DomainTdDateV1.Confirm().%s => listener not notified", tt.name)
+				}
+			}
+		})
+	}
+}
+
+type noShowListenerFunc func(context.Context, TdDate)
+
+func (f noShowListenerFunc) NoShow(ctx context.Context, td_ TdDate) { f(ctx, td_) }
+
+func TestDomainTdDateV1_MarkAttendance(t *testing.T) {
+	ctx := context.TODO()
+
+	day := []TdDate{
+		{Id: 1, Confirm: null.TimeFrom(time.Now()), Email: null.StringFrom("smith@example.com")},
+		{Id: 2, Confirm: null.TimeFrom(time.Now()), Email: null.StringFrom("jones@example.com")},
+		{Id: 3, Confirm: null.TimeFrom(time.Now()), Outcome: null.StringFrom(OutcomeNoShow)},
+	}
+	tests := []struct {
+		name        string
+		attendance  AttendanceRequest
+		wantErr     bool
+		wantMarked  map[int]string
+		wantNoShows []int
+	}{
+		{
+			"successful - rest attended",
+			AttendanceRequest{Date: "2025-12-07", Outcomes: []AttendanceOutcome{{Id: 2, Outcome: OutcomeNoShow}}, Rest: null.StringFrom(OutcomeAttended)},
+			false,
+			map[int]string{1: OutcomeAttended, 2: OutcomeNoShow, 3: OutcomeAttended},
+			[]int{2},
+		},
+		{
+			"successful - rest left alone, already no-show isn't followed up again",
+			AttendanceRequest{Date: "2025-12-07", Outcomes: []AttendanceOutcome{{Id: 1, Outcome: OutcomeRescheduled}, {Id: 3, Outcome: OutcomeNoShow}}},
+			false,
+			map[int]string{1: OutcomeRescheduled},
+			nil,
+		},
+		{
+			"failed - not that day's booking",
+			AttendanceRequest{Date: "2025-12-07", Outcomes: []AttendanceOutcome{{Id: 9, Outcome: OutcomeAttended}}},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"failed - unknown outcome",
+			AttendanceRequest{Date: "2025-12-07", Outcomes: []AttendanceOutcome{{Id: 1, Outcome: "late"}}},
+			true,
+			nil,
+			nil,
+		},
+		{
+			"failed - date format",
+			AttendanceRequest{Date: "12/07/2025"},
+			true,
+			nil,
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+			mockDataTdDate.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tds *[]TdDate, _ TdDateParam) (int, error) {
+				*tds = append([]TdDate{}, day...)
+				return len(day), nil
+			}).AnyTimes()
+			marked := map[int]string{}
+			mockDataTdDate.EXPECT().SetOutcome(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, td_ TdDate) error {
+				marked[td_.Id] = td_.Outcome.String
+				return nil
+			}).AnyTimes()
+			noShows := make(chan int, len(day))
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate}
+			m.AddNoShowListener(noShowListenerFunc(func(_ context.Context, td_ TdDate) { noShows <- td_.Id }))
+			attendance := tt.attendance
+			err := m.MarkAttendance(ctx, &attendance)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainTdDateV1.MarkAttendance().%s => expected error: got: %s", tt.name, err)
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, tt.wantMarked, marked, "DomainTdDateV1.MarkAttendance().%s => unexpected outcomes", tt.name)
+			assert.Equal(t, len(tt.wantMarked), attendance.Marked)
+			assert.Equal(t, len(tt.wantNoShows), attendance.NoShows)
+			for _, id := range tt.wantNoShows {
+				select {
+				case got := <-noShows:
+					assert.Equal(t, id, got, "DomainTdDateV1.MarkAttendance().%s => unexpected no-show", tt.name)
+				case <-time.After(time.Second):
+					t.Errorf("DomainTdDateV1.MarkAttendance().%s => listener not notified", tt.name)
+				}
+			}
+		})
+	}
+}
+
+type releaseListenerFunc func(context.Context, TdDate)
+
+func (f releaseListenerFunc) SlotReleased(ctx context.Context, td_ TdDate) { f(ctx, td_) }
+
+func TestDomainTdDateV1_PatchRelease(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Now().UTC()
+	booked := TdDate{Id: 4, DateValue: null.TimeFrom(now.AddDate(0, 0, 1)), Hold: null.TimeFrom(now), Confirm: null.TimeFrom(now), Name: null.StringFrom("Smith Family"), Email: null.StringFrom("smith@example.com"), Phone: null.StringFrom("+18015550123"), HouseholdId: null.IntFrom(7), ManageToken: null.StringFrom("manage-token")}
+
+	tests := []struct {
+		name         string
+		patch        TdDate
+		wantReleased bool
+		wantName     null.String
+		wantPhone    null.String
+	}{
+		{"cleared hold and confirm", TdDate{Id: 4, Hold: null.TimeFrom(time.Time{}), Confirm: null.TimeFrom(time.Time{})}, true, null.String{}, null.String{}},
+		{"cleared confirm only, still held", TdDate{Id: 4, Confirm: null.TimeFrom(time.Time{})}, false, booked.Name, booked.Phone},
+		{"name changed", TdDate{Id: 4, Name: null.StringFrom("Smith Household")}, false, null.StringFrom("Smith Household"), booked.Phone},
+		{"phone cleared", TdDate{Id: 4, Phone: null.StringFrom("")}, false, booked.Name, null.String{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+			mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+			mockDataSeason.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+			mockDataTdDate.EXPECT().Read(ctx, gomock.Any()).SetArg(1, booked).Return(nil)
+			var saved TdDate
+			mockDataTdDate.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, td_ TdDate) error {
+				saved = td_
+				return nil
+			})
+			released := make(chan int, 1)
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataSeasonV1: mockDataSeason}
+			m.AddSlotReleaseListener(releaseListenerFunc(func(_ context.Context, td_ TdDate) { released <- td_.Id }))
+			err := m.Patch(ctx, tt.patch)
+			assert.Nil(t, err, "DomainTdDateV1.Patch().%s => unexpected error: %s", tt.name, err)
+			assert.Equal(t, tt.wantReleased, !saved.ManageToken.Valid, "DomainTdDateV1.Patch().%s => manage token", tt.name)
+			assert.Equal(t, tt.wantName, saved.Name, "DomainTdDateV1.Patch().%s => name", tt.name)
+			assert.Equal(t, tt.wantPhone, saved.Phone, "DomainTdDateV1.Patch().%s => phone", tt.name)
+			if tt.wantReleased {
+				assert.False(t, saved.Email.Valid || saved.HouseholdId.Valid, "DomainTdDateV1.Patch().%s => the family was left on the slot", tt.name)
+			}
+			select {
+			case id := <-released:
+				assert.True(t, tt.wantReleased, "DomainTdDateV1.Patch().%s => listener notified", tt.name)
+				assert.Equal(t, 4, id)
+			case <-time.After(100 * time.Millisecond):
+				assert.False(t, tt.wantReleased, "DomainTdDateV1.Patch().%s => listener not notified", tt.name)
+			}
+		})
+	}
+}
+
+func TestDomainTdDateV1_ChangeRange(t *testing.T) {
+	ctx := context.TODO()
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "America/Denver"
+
+	archived := ssn.Season{Id: 1, Name: null.StringFrom("2024"), OpenDate: null.StringFrom("2024-12-01"), CloseDate: null.StringFrom("2024-12-31"), Archived: null.BoolFrom(true)}
+	unbooked := []TdDate{{Id: 1}, {Id: 2, Hold: null.TimeFrom(time.Now())}}
+	closed := []TdDate{{Id: 1, ClosedAt: null.TimeFrom(time.Now())}}
+	booked := []TdDate{
+		{Id: 3, Confirm: null.TimeFrom(time.Now()), Email: null.StringFrom("smith@example.com"), ManageToken: null.StringFrom("manage-token")},
+		{Id: 4, Confirm: null.TimeFrom(time.Now()), Phone: null.StringFrom("801-555-0100")},
+	}
+	tests := []struct {
+		name         string
+		req          SlotRangeRequest
+		changed      []TdDate
+		wantErr      bool
+		wantFrom     time.Time
+		wantTo       time.Time
+		wantNotified int
+		wantReleased int
+	}{
+		{
+			"successful - close the day and ask the families to reschedule",
+			SlotRangeRequest{Action: RangeClose, From: "2025-10-05", NotifyBooked: true},
+			unbooked,
+			false,
+			time.Date(2025, 10, 5, 6, 0, 0, 0, time.UTC),
+			time.Date(2025, 10, 6, 6, 0, 0, 0, time.UTC),
+			1,
+			0,
+		},
+		{
+			"successful - delete a morning",
+			SlotRangeRequest{Action: RangeDelete, From: "2025-10-05 09:00", To: "2025-10-05 12:00", InterviewerId: 2},
+			unbooked,
+			false,
+			time.Date(2025, 10, 5, 15, 0, 0, 0, time.UTC),
+			time.Date(2025, 10, 5, 18, 0, 0, 0, time.UTC),
+			0,
+			0,
+		},
+		{
+			"successful - open the days back up",
+			SlotRangeRequest{Action: RangeOpen, From: "2025-10-05", To: "2025-10-12"},
+			closed,
+			false,
+			time.Date(2025, 10, 5, 6, 0, 0, 0, time.UTC),
+			time.Date(2025, 10, 13, 6, 0, 0, 0, time.UTC),
+			0,
+			1,
+		},
+		{"failed - action", SlotRangeRequest{Action: "cancel", From: "2025-10-05"}, nil, true, time.Time{}, time.Time{}, 0, 0},
+		{"failed - missing from", SlotRangeRequest{Action: RangeClose}, nil, true, time.Time{}, time.Time{}, 0, 0},
+		{"failed - from format", SlotRangeRequest{Action: RangeClose, From: "10/05/2025"}, nil, true, time.Time{}, time.Time{}, 0, 0},
+		{"failed - to before from", SlotRangeRequest{Action: RangeClose, From: "2025-10-05 12:00", To: "2025-10-05 09:00"}, nil, true, time.Time{}, time.Time{}, 0, 0},
+		{"failed - archived season", SlotRangeRequest{Action: RangeDelete, From: "2024-11-30", To: "2024-12-01"}, nil, true, time.Time{}, time.Time{}, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+			mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+			mockEmailer := email.NewMockEmailer(ctrl)
+			mockDataSeason.EXPECT().List(ctx, gomock.Any()).SetArg(1, []ssn.Season{archived}).Return(nil).AnyTimes()
+			mockDataTdDate.EXPECT().ChangeRange(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, sr SlotRange, changed, b *[]TdDate) error {
+				assert.Equal(t, tt.req.Action, sr.Action)
+				assert.Equal(t, tt.req.InterviewerId, sr.InterviewerId)
+				assert.Equal(t, tt.wantFrom, sr.From, "DomainTdDateV1.ChangeRange().%s => unexpected from", tt.name)
+				assert.Equal(t, tt.wantTo, sr.To, "DomainTdDateV1.ChangeRange().%s => unexpected to", tt.name)
+				*changed = append([]TdDate{}, tt.changed...)
+				*b = append([]TdDate{}, booked...)
+				return nil
+			}).MaxTimes(1)
+			emailed := make(chan string, len(booked))
+			mockEmailer.EXPECT().SendRescheduleRequest(ctx, "smith@example.com", gomock.Any(), "manage-token").DoAndReturn(func(_ context.Context, to, _, _ string) error {
+				emailed <- to
+				return nil
+			}).Times(tt.wantNotified)
+			released := make(chan int, len(tt.changed))
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataSeasonV1: mockDataSeason, emailer: mockEmailer}
+			m.AddSlotReleaseListener(releaseListenerFunc(func(_ context.Context, td_ TdDate) { released <- td_.Id }))
+			req := tt.req
+			err := m.ChangeRange(ctx, &req)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainTdDateV1.ChangeRange().%s => expected error: got: %s", tt.name, err)
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, len(tt.changed), len(req.Changed))
+			assert.Equal(t, booked, req.Booked, "DomainTdDateV1.ChangeRange().%s => bookings are reported", tt.name)
+			assert.Equal(t, tt.wantNotified, req.Notified)
+			for i := 0; i < tt.wantNotified; i++ {
+				select {
+				case <-emailed:
+				case <-time.After(time.Second):
+					t.Errorf("DomainTdDateV1.ChangeRange().%s => family not emailed", tt.name)
+				}
+			}
+			for i := 0; i < tt.wantReleased; i++ {
+				select {
+				case <-released:
+				case <-time.After(time.Second):
+					t.Errorf("DomainTdDateV1.ChangeRange().%s => listener not notified", tt.name)
+				}
+			}
+		})
+	}
+}
+
+func TestDomainTdDateV1_Stats(t *testing.T) {
+	ctx := context.TODO()
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "America/Denver"
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+
+	agg := StatsAggregate{
+		Starts: []StatsStartCounts{
+			{Start: "2025-12-07 16:00", SlotCounts: SlotCounts{Slots: 2, Confirmed: 2}},
+			{Start: "2025-12-08 02:00", SlotCounts: SlotCounts{Slots: 2, Held: 1, Open: 1}}, // Sunday 7 pm in Denver
+			{Start: "2025-12-08 16:00", SlotCounts: SlotCounts{Slots: 4, Confirmed: 1, Open: 3}},
+		},
+		Seasons:  []SeasonStats{{SeasonId: null.IntFrom(1), Name: null.StringFrom("2025"), SlotCounts: SlotCounts{Slots: 8, Held: 1, Confirmed: 3, Open: 4}}},
+		LeadTime: LeadTime{Bookings: 3, AverageDays: 4.5, MinDays: 1, MaxDays: 9},
+	}
+	mockDataTdDate.EXPECT().Stats(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *StatsAggregate, param TdDateParam) error {
+		assert.Equal(t, "t.date_value", param.ColumnMapping["date_value"])
+		*a = agg
+		return nil
+	}).Times(1)
+
+	m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate}
+	stats := &TdDateStats{}
+	assert.Nil(t, m.Stats(ctx, stats, StatsRequest{From: "2025-12-01", To: "2025-12-31", SeasonId: 1}))
+	assert.Equal(t, SlotCounts{Slots: 8, Held: 1, Confirmed: 3, Open: 4, FillRate: 0.375}, stats.SlotCounts)
+	assert.Equal(t, []DayStats{
+		{Date: "2025-12-07", SlotCounts: SlotCounts{Slots: 4, Held: 1, Confirmed: 2, Open: 1, FillRate: 0.5}},
+		{Date: "2025-12-08", SlotCounts: SlotCounts{Slots: 4, Confirmed: 1, Open: 3, FillRate: 0.25}},
+	}, stats.Days)
+	assert.Equal(t, "Sunday", stats.Weekdays[0].Weekday)
+	assert.Equal(t, "Monday", stats.Weekdays[1].Weekday)
+	assert.Equal(t, []int{9, 19}, []int{stats.Hours[0].Hour, stats.Hours[1].Hour})
+	assert.Equal(t, 3, stats.Hours[0].Confirmed)
+	assert.Equal(t, 0.375, stats.Seasons[0].FillRate)
+	assert.Equal(t, agg.LeadTime, stats.LeadTime)
+	assert.Equal(t, "America/Denver", stats.Timezone)
+
+	assert.NotNil(t, m.Stats(ctx, stats, StatsRequest{From: "2025-12-31", To: "2025-12-01"}), "DomainTdDateV1.Stats() => To before From")
+	assert.NotNil(t, m.Stats(ctx, stats, StatsRequest{From: "12/01/2025"}), "DomainTdDateV1.Stats() => From format")
+}
+
+func TestDomainTdDateV1_Season(t *testing.T) {
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "UTC"
+	ctx := context.TODO()
+
+	now := time.Now().UTC()
+	year := now.Year()
+	open := ssn.Season{Id: 2, Name: null.StringFrom("This year"), OpenDate: null.StringFrom(now.AddDate(0, 0, -7).Format("2006-01-02")), CloseDate: null.StringFrom(now.AddDate(0, 0, 7).Format("2006-01-02"))}
+	archived := ssn.Season{Id: 1, Name: null.StringFrom("Last year"), OpenDate: null.StringFrom(strconv.Itoa(year-1) + "-01-01"), CloseDate: null.StringFrom(strconv.Itoa(year-1) + "-01-31"), Archived: null.BoolFrom(true)}
+	later := now.AddDate(0, 0, 14)
+
+	tests := []struct {
+		name         string
+		run          func(m *DomainTdDateV1) error
+		wantErr      bool
+		wantSeasonId null.Int
+	}{
+		{
+			"create - slot put in the season",
+			func(m *DomainTdDateV1) error {
+				return m.CreateBlock(ctx, TdDateBlock{NewDate: null.StringFrom(now.Format("2006-01-02")), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("09:15")})
+			},
+			false,
+			null.IntFrom(2),
+		},
+		{
+			"create - slot outside of any season",
+			func(m *DomainTdDateV1) error {
+				return m.CreateBlock(ctx, TdDateBlock{NewDate: null.StringFrom(later.Format("2006-01-02")), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("09:15")})
+			},
+			false,
+			null.Int{},
+		},
+		{
+			"create - failed archived season",
+			func(m *DomainTdDateV1) error {
+				return m.CreateBlock(ctx, TdDateBlock{NewDate: null.StringFrom(strconv.Itoa(year-1) + "-01-15"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("09:15")})
+			},
+			true,
+			null.Int{},
+		},
+		{
+			"hold - failed outside the open season",
+			func(m *DomainTdDateV1) error {
+				return m.CheckSetHoldTime(ctx, &CheckHoldTimeRequest{Date: later.Format("2006-01-02"), Time: "09:00 AM"})
+			},
+			true,
+			null.Int{},
+		},
+		{
+			"delete - failed archived season",
+			func(m *DomainTdDateV1) error {
+				return m.Delete(ctx, &TdDate{Id: 9})
+			},
+			true,
+			null.Int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+			mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+			mockDataSeason.EXPECT().List(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, seasons *[]ssn.Season) error {
+				*seasons = []ssn.Season{archived, open}
+				return nil
+			}).AnyTimes()
+			mockDataTdDate.EXPECT().Read(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, td *TdDate) error {
+				td.SeasonId = null.IntFrom(1)
+				return nil
+			}).AnyTimes()
+			mockDataTdDate.EXPECT().Exists(ctx, gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+			created := []TdDate{}
+			mockDataTdDate.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, td *TdDate) error {
+				created = append(created, *td)
+				return nil
+			}).AnyTimes()
+			mockDataBlackoutDate := bod.NewMockDataBlackoutDateV1Adapter(ctrl)
+			mockDataBlackoutDate.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataSeasonV1: mockDataSeason, dataBlackoutDateV1: mockDataBlackoutDate}
+			err := tt.run(m)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainTdDateV1.%s => expected error: got: %s", tt.name, err)
+			if !tt.wantErr {
+				assert.Len(t, created, 1)
+				assert.Equal(t, tt.wantSeasonId, created[0].SeasonId, "DomainTdDateV1.%s => unexpected season", tt.name)
+			}
+		})
+	}
+}
+
+func TestDomainTdDateV1_GetCurrentDays(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+	mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+	mockDataBlackoutDate := bod.NewMockDataBlackoutDateV1Adapter(ctrl)
+	mockDataSeason.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+	mockDataBlackoutDate.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+	nullColumns := []string{}
+	mockDataTdDate.EXPECT().GetCurrentDays(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *[]TdDate, param TdDateParam) error {
+		for _, f := range param.Param.Search.Filters {
+			if f.Compare == "NULL" {
+				nullColumns = append(nullColumns, f.Column)
+			}
+		}
+		return nil
+	})
+	m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataSeasonV1: mockDataSeason, dataBlackoutDateV1: mockDataBlackoutDate}
+
+	assert.Nil(t, m.GetCurrentDays(ctx, &CurrentDateTime{}))
+	// a confirmed slot whose hold was cleared is still booked
+	assert.ElementsMatch(t, []string{"hold", "confirm", "closed_at"}, nullColumns)
+}
+
+func TestDomainTdDateV1_OverlappingSeasons(t *testing.T) {
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "UTC"
+	ctx := context.TODO()
+
+	// next season is bookable before this one closes
+	now := time.Now().UTC()
+	current := ssn.Season{Id: 2, Name: null.StringFrom("This year"), OpenDate: null.StringFrom(now.AddDate(0, 0, -7).Format("2006-01-02")), CloseDate: null.StringFrom(now.AddDate(0, 0, 7).Format("2006-01-02"))}
+	next := ssn.Season{Id: 3, Name: null.StringFrom("Next year"), OpenDate: null.StringFrom(now.AddDate(0, 0, 21).Format("2006-01-02")), CloseDate: null.StringFrom(now.AddDate(0, 0, 35).Format("2006-01-02")), BookableFrom: null.StringFrom(now.AddDate(0, 0, -1).Format("2006-01-02"))}
+	upcoming := ssn.Season{Id: 4, Name: null.StringFrom("Year after"), OpenDate: null.StringFrom(now.AddDate(0, 0, 60).Format("2006-01-02")), CloseDate: null.StringFrom(now.AddDate(0, 0, 90).Format("2006-01-02"))}
+
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+	mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+	mockDataBlackoutDate := bod.NewMockDataBlackoutDateV1Adapter(ctrl)
+	mockDataSeason.EXPECT().List(ctx, gomock.Any()).SetArg(1, []ssn.Season{current, next, upcoming}).Return(nil).AnyTimes()
+	mockDataBlackoutDate.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+	mockDataTdDate.EXPECT().CheckSetHoldTime(ctx, gomock.Any()).Return(nil).AnyTimes()
+	var seasonFilter h.Filter
+	mockDataTdDate.EXPECT().GetCurrentDays(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ *[]TdDate, param TdDateParam) error {
+		for _, f := range param.Param.Search.Filters {
+			if f.Column == "season_id" {
+				seasonFilter = f
+			}
+		}
+		return nil
+	}).Times(1)
+	m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataSeasonV1: mockDataSeason, dataBlackoutDateV1: mockDataBlackoutDate}
+
+	assert.Nil(t, m.GetCurrentDays(ctx, &CurrentDateTime{}))
+	assert.Equal(t, h.Filter{Column: "season_id", Compare: "IN", Value: []int{2, 3}}, seasonFilter, "both bookable seasons are offered")
+
+	assert.Nil(t, m.CheckSetHoldTime(ctx, &CheckHoldTimeRequest{Date: now.AddDate(0, 0, 1).Format("2006-01-02"), Time: "09:00 AM"}), "this season can still be booked")
+	assert.Nil(t, m.CheckSetHoldTime(ctx, &CheckHoldTimeRequest{Date: now.AddDate(0, 0, 28).Format("2006-01-02"), Time: "09:00 AM"}), "next season can already be booked")
+	assert.NotNil(t, m.CheckSetHoldTime(ctx, &CheckHoldTimeRequest{Date: now.AddDate(0, 0, 70).Format("2006-01-02"), Time: "09:00 AM"}), "the season after is not bookable yet")
+}
+
+func TestDomainTdDateV1_BlackoutDate(t *testing.T) {
+	ctx := context.TODO()
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "America/Denver"
+
+	conference := bod.BlackoutDate{Id: 1, Name: null.StringFrom("Stake Conference"), StartDate: null.StringFrom("2025-11-08"), EndDate: null.StringFrom("2025-11-09")}
+	sundays := json.RawMessage(`["Sunday"]`)
+	template := st.ScheduleTemplate{Id: 1, StartDate: null.StringFrom("2025-11-02"), EndDate: null.StringFrom("2025-11-16"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("09:30"), SlotDuration: null.IntFrom(15), Weekdays: &sundays}
+	slot := func(day string) TdDate {
+		start, _ := time.ParseInLocation("2006-01-02 15:04", day+" 09:00", config.Sch.GetTimezone())
+		return TdDate{DateValue: null.TimeFrom(start.UTC()), EndValue: null.TimeFrom(start.Add(15 * time.Minute).UTC())}
+	}
+
+	tests := []struct {
+		name        string
+		run         func(m *DomainTdDateV1) error
+		wantErr     bool
+		wantCreated []string
+	}{
+		{
+			"create block - failed blackout date",
+			func(m *DomainTdDateV1) error {
+				return m.CreateBlock(ctx, TdDateBlock{NewDate: null.StringFrom("2025-11-09"), StartTime: null.StringFrom("09:00"), EndTime: null.StringFrom("10:00")})
+			},
+			true,
+			nil,
+		},
+		{
+			"expand template - blackout date left empty",
+			func(m *DomainTdDateV1) error {
+				expand := &ExpandTemplateRequest{ScheduleTemplateId: 1}
+				if err := m.ExpandTemplate(ctx, expand); err != nil {
+					return err
+				}
+				assert.Equal(t, 2, expand.BlackedOut)
+				return nil
+			},
+			false,
+			[]string{"2025-11-02", "2025-11-02", "2025-11-16", "2025-11-16"},
+		},
+		{
+			"post - failed blackout date",
+			func(m *DomainTdDateV1) error {
+				td_ := slot("2025-11-08")
+				return m.Post(ctx, &td_)
+			},
+			true,
+			nil,
+		},
+		{
+			"hold - failed blackout date",
+			func(m *DomainTdDateV1) error {
+				return m.CheckSetHoldTime(ctx, &CheckHoldTimeRequest{Date: "2025-11-09", Time: "09:00 AM"})
+			},
+			true,
+			nil,
+		},
+		{
+			"current days - blackout date hidden",
+			func(m *DomainTdDateV1) error {
+				current := &CurrentDateTime{}
+				if err := m.GetCurrentDays(ctx, current); err != nil {
+					return err
+				}
+				assert.Equal(t, map[string][]string{"2025-11-02": {"09:00 AM - 09:15 AM"}}, current.DayAndTimes)
+				return nil
+			},
+			false,
+			[]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+			mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+			mockDataBlackoutDate := bod.NewMockDataBlackoutDateV1Adapter(ctrl)
+			mockDataScheduleTemplate := st.NewMockDataScheduleTemplateV1Adapter(ctrl)
+			mockDataSeason.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+			mockDataBlackoutDate.EXPECT().List(ctx, gomock.Any()).SetArg(1, []bod.BlackoutDate{conference}).Return(nil).AnyTimes()
+			mockDataScheduleTemplate.EXPECT().Read(ctx, gomock.Any()).SetArg(1, template).Return(nil).AnyTimes()
+			mockDataTdDate.EXPECT().GetCurrentDays(ctx, gomock.Any(), gomock.Any()).SetArg(1, []TdDate{slot("2025-11-02"), slot("2025-11-09")}).Return(nil).AnyTimes()
+			mockDataTdDate.EXPECT().Exists(ctx, gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+			created := []string{}
+			mockDataTdDate.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, td *TdDate) error {
+				created = append(created, td.LocalDay())
+				return nil
+			}).AnyTimes()
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataSeasonV1: mockDataSeason, dataBlackoutDateV1: mockDataBlackoutDate, dataScheduleTemplateV1: mockDataScheduleTemplate}
+			err := tt.run(m)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainTdDateV1.%s => expected error: got: %s", tt.name, err)
+			if !tt.wantErr {
+				assert.Equal(t, tt.wantCreated, created, "DomainTdDateV1.%s => unexpected slots", tt.name)
+			}
+		})
+	}
+}
+
+func TestFormatDateTime(t *testing.T) {
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+
+	tests := []struct {
+		name     string
+		timezone string
+		request  CheckHoldTimeRequest
+		want     time.Time
+		wantErr  bool
+	}{
+		{"successful - utc", "UTC", CheckHoldTimeRequest{Date: "2025-12-07", Time: "09:00 AM"}, time.Date(2025, 12, 7, 9, 0, 0, 0, time.UTC), false},
+		{"successful - local to utc", "America/Denver", CheckHoldTimeRequest{Date: "2025-12-07", Time: "09:00 AM - 09:15 AM"}, time.Date(2025, 12, 7, 16, 0, 0, 0, time.UTC), false},
+		{"successful - local summer time", "America/Denver", CheckHoldTimeRequest{Date: "2025-07-06", Time: "09:00 AM"}, time.Date(2025, 7, 6, 15, 0, 0, 0, time.UTC), false},
+		{"failed - format", "UTC", CheckHoldTimeRequest{Date: "12/07/2025", Time: "09:00 AM"}, time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config.Sch.Timezone = tt.timezone
+			got, err := formatDateTime(tt.request)
+			assert.Equal(t, tt.wantErr, err != nil, "formatDateTime().%s => expected error: got: %s", tt.name, err)
+			assert.True(t, tt.want.Equal(got), "formatDateTime().%s => got: %s, want: %s", tt.name, got, tt.want)
+			if !tt.wantErr {
+				td_ := TdDate{DateValue: null.TimeFrom(got), EndValue: null.TimeFrom(got.Add(15 * time.Minute))}
+				assert.Equal(t, tt.request.Date, td_.LocalDay(), "TdDate.LocalDay().%s => unexpected day", tt.name)
+				assert.True(t, strings.HasPrefix(td_.DisplayTime(), "09:00 AM"), "TdDate.DisplayTime().%s => got: %s", tt.name, td_.DisplayTime())
+			}
+		})
+	}
+}
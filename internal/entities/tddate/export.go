@@ -0,0 +1,315 @@
+package tddate
+
+import (
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"html/template"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	itv "github.com/blackflagsoftware/tithe-declare/internal/entities/interviewer"
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/xlsx"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	exportColumn struct {
+		name   string
+		header string
+		value  func(td_ TdDate, interviewers map[int]string) string
+	}
+
+	agendaDay struct {
+		Title string // e.g.: Sunday, December 7, 2025
+		Rows  []agendaRow
+	}
+
+	agendaRow struct {
+		Time        string
+		Name        string
+		Phone       string
+		Email       string
+		Interviewer string
+	}
+)
+
+const (
+	exportPageSize = 500
+	agendaMaxDays  = 31
+)
+
+// the columns that can be exported, in the order they are exported when none are picked
+var exportColumns = []exportColumn{
+	{"id", "Id", func(td_ TdDate, _ map[int]string) string { return strconv.Itoa(td_.Id) }},
+	{"date", "Date", func(td_ TdDate, _ map[int]string) string { return td_.LocalDay() }},
+	{"time", "Time", func(td_ TdDate, _ map[int]string) string { return td_.DisplayTime() }},
+	{"interviewer", "Interviewer", func(td_ TdDate, interviewers map[int]string) string { return interviewers[td_.InterviewerId] }},
+	{"status", "Status", func(td_ TdDate, _ map[int]string) string { return td_.Status() }},
+	{"name", "Name", func(td_ TdDate, _ map[int]string) string { return td_.Name.String }},
+	{"email", "Email", func(td_ TdDate, _ map[int]string) string { return td_.Email.String }},
+	{"phone", "Phone", func(td_ TdDate, _ map[int]string) string { return td_.Phone.String }},
+	{"outcome", "Outcome", func(td_ TdDate, _ map[int]string) string { return td_.Outcome.String }},
+	{"household_id", "Household Id", func(td_ TdDate, _ map[int]string) string { return nullIntString(td_.HouseholdId) }},
+	{"season_id", "Season Id", func(td_ TdDate, _ map[int]string) string { return nullIntString(td_.SeasonId) }},
+}
+
+//go:embed templates/agenda.html
+var agendaSrc string
+
+var agendaTmpl = template.Must(template.New("agenda").Parse(agendaSrc))
+
+// Export pages through Search so a large export isn't read all at once, each is given a page of slots at a time
+// the first page is read before each is called and each is called at least once (with no slots if nothing matched)
+// so nothing is written when the search itself fails
+func (m *DomainTdDateV1) Export(ctx context.Context, param TdDateParam, each func([]TdDate) error) error {
+	param.Search.Sort = exportSort(param.Search.Sort)
+	param.Search.Pagination = h.Pagination{PageLimit: exportPageSize, PageNumber: 1}
+	for {
+		page := []TdDate{}
+		if _, err := m.Search(ctx, &page, param); err != nil {
+			return err
+		}
+		if err := each(page); err != nil {
+			return err
+		}
+		if len(page) < exportPageSize {
+			return nil
+		}
+		param.Search.Pagination.PageNumber++
+	}
+}
+
+// ExportCSV streams the slots matching req as csv to w
+func (m *DomainTdDateV1) ExportCSV(ctx context.Context, w io.Writer, req ExportRequest) error {
+	cw := csv.NewWriter(w)
+	return m.exportRows(ctx, req, cw.Write, func(row []string) error {
+		for i := range row {
+			row[i] = csvSafe(row[i])
+		}
+		return cw.Write(row)
+	}, func() error {
+		cw.Flush()
+		return cw.Error()
+	})
+}
+
+// ExportXLSX streams the slots matching req as a spreadsheet to w
+func (m *DomainTdDateV1) ExportXLSX(ctx context.Context, w io.Writer, req ExportRequest) error {
+	var xw *xlsx.Writer
+	err := m.exportRows(ctx, req, func(header []string) error {
+		var err error
+		if xw, err = xlsx.NewWriter(w, "Declarations"); err != nil {
+			return err
+		}
+		return xw.WriteHeader(header)
+	}, func(row []string) error {
+		return xw.WriteRow(row)
+	}, func() error {
+		return xw.Flush()
+	})
+	if err != nil {
+		return err
+	}
+	if err := xw.Close(); err != nil {
+		return ae.GeneralError("TdDate Export: unable to finish spreadsheet", err)
+	}
+	return nil
+}
+
+// exportRows writes the header then a row per slot, flush is called after every page
+// header isn't called until the first page is read, a bad column or search is returned before anything is written
+func (m *DomainTdDateV1) exportRows(ctx context.Context, req ExportRequest, header, row func([]string) error, flush func() error) error {
+	columns, err := pickColumns(req.Columns)
+	if err != nil {
+		return err
+	}
+	interviewers, err := m.interviewerNames(ctx)
+	if err != nil {
+		return err
+	}
+	started := false
+	return m.Export(ctx, req.TdDateParam, func(page []TdDate) error {
+		if !started {
+			started = true
+			headers := []string{}
+			for _, col := range columns {
+				headers = append(headers, col.header)
+			}
+			if err := header(headers); err != nil {
+				return ae.GeneralError("TdDate Export: unable to write header", err)
+			}
+		}
+		for _, td_ := range page {
+			values := []string{}
+			for _, col := range columns {
+				values = append(values, col.value(td_, interviewers))
+			}
+			if err := row(values); err != nil {
+				return ae.GeneralError("TdDate Export: unable to write row", err)
+			}
+		}
+		if err := flush(); err != nil {
+			return ae.GeneralError("TdDate Export: unable to write rows", err)
+		}
+		return nil
+	})
+}
+
+// Agenda writes a printable html page per day from req.From through req.To with that day's confirmed bookings
+func (m *DomainTdDateV1) Agenda(ctx context.Context, w io.Writer, req AgendaRequest) error {
+	tz := config.Sch.GetTimezone()
+	if req.From == "" {
+		req.From = time.Now().In(tz).Format(layoutDate)
+	}
+	if req.To == "" {
+		req.To = req.From
+	}
+	from, errFrom := time.ParseInLocation(layoutDate, req.From, tz)
+	if errFrom != nil {
+		return ae.ParseError("From not in correct format")
+	}
+	to, errTo := time.ParseInLocation(layoutDate, req.To, tz)
+	if errTo != nil {
+		return ae.ParseError("To not in correct format")
+	}
+	if to.Before(from) {
+		return ae.ParseError("To must not be before From")
+	}
+	if to.After(from.AddDate(0, 0, agendaMaxDays-1)) {
+		return ae.ParseError(fmt.Sprintf("the agenda covers at most %d days", agendaMaxDays))
+	}
+	interviewers, err := m.interviewerNames(ctx)
+	if err != nil {
+		return err
+	}
+	days := []agendaDay{}
+	index := make(map[string]int)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		index[day.Format(layoutDate)] = len(days)
+		days = append(days, agendaDay{Title: day.Format("Monday, January 2, 2006")})
+	}
+	param := TdDateParam{
+		Param: h.Param{
+			Search: h.Search{
+				Filters: []h.Filter{
+					{Column: "date_value", Compare: ">=", Value: from.UTC()},
+					{Column: "date_value", Compare: "<", Value: to.AddDate(0, 0, 1).UTC()},
+					{Column: "confirm", Compare: "NOT NULL", Value: nil},
+				},
+				Sort: "date_value,interviewer_id",
+			},
+		},
+	}
+	errExport := m.Export(ctx, param, func(page []TdDate) error {
+		for _, td_ := range page {
+			i, ok := index[td_.LocalDay()]
+			if !ok {
+				continue
+			}
+			days[i].Rows = append(days[i].Rows, agendaRow{
+				Time:        td_.DisplayTime(),
+				Name:        td_.Name.String,
+				Phone:       td_.Phone.String,
+				Email:       td_.Email.String,
+				Interviewer: interviewers[td_.InterviewerId],
+			})
+		}
+		return nil
+	})
+	if errExport != nil {
+		return errExport
+	}
+	data := struct {
+		Location string
+		Days     []agendaDay
+	}{Location: config.Sch.Location, Days: days}
+	if err := agendaTmpl.Execute(w, data); err != nil {
+		return ae.GeneralError("TdDate Agenda: unable to write agenda", err)
+	}
+	return nil
+}
+
+// Status is where the slot stands: open, held, confirmed or closed
+func (td TdDate) Status() string {
+	switch {
+	case td.Confirm.Valid:
+		return "confirmed"
+	case td.ClosedAt.Valid:
+		return "closed"
+	case td.Hold.Valid:
+		return "held"
+	}
+	return "open"
+}
+
+func (m *DomainTdDateV1) interviewerNames(ctx context.Context) (map[int]string, error) {
+	names := make(map[int]string)
+	interviewers := []itv.Interviewer{}
+	param := itv.InterviewerParam{}
+	param.Param.CalculateParam("id", map[string]string{"id": "id"})
+	if _, err := m.dataInterviewerV1.ReadAll(ctx, &interviewers, param); err != nil {
+		return nil, err
+	}
+	for _, interviewer := range interviewers {
+		names[interviewer.Id] = interviewer.Name.String
+	}
+	return names, nil
+}
+
+// pickColumns is the columns named, in that order; none named => all of them
+func pickColumns(names []string) ([]exportColumn, error) {
+	if len(names) == 0 {
+		return exportColumns, nil
+	}
+	columns := []exportColumn{}
+	fields := []ae.FieldError{}
+	for i, name := range names {
+		idx := slices.IndexFunc(exportColumns, func(col exportColumn) bool { return col.name == strings.TrimSpace(name) })
+		if idx == -1 {
+			fields = append(fields, ae.FieldError{Field: fmt.Sprintf("columns[%d]", i), Message: "is not a column that can be exported"})
+			continue
+		}
+		columns = append(columns, exportColumns[idx])
+	}
+	if len(fields) > 0 {
+		return nil, ae.ValidationError(fields)
+	}
+	return columns, nil
+}
+
+// exportSort adds id to the sort so paging through the export never skips or repeats a slot
+func exportSort(sort string) string {
+	if sort == "" {
+		sort = "date_value"
+	}
+	for _, s := range strings.Split(sort, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(s), "-") == "id" {
+			return sort
+		}
+	}
+	return sort + ",id"
+}
+
+// csvSafe keeps a value the family typed from being run as a formula when the csv is opened in a spreadsheet
+// the xlsx export writes inline strings and doesn't need it
+func csvSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func nullIntString(i null.Int) string {
+	if !i.Valid {
+		return ""
+	}
+	return strconv.FormatInt(i.Int64, 10)
+}
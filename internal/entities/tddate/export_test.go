@@ -0,0 +1,148 @@
+package tddate
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	itv "github.com/blackflagsoftware/tithe-declare/internal/entities/interviewer"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDomainTdDateV1_ExportCSV(t *testing.T) {
+	ctx := context.TODO()
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "UTC"
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+	mockDataInterviewer := itv.NewMockDataInterviewerV1Adapter(ctrl)
+	mockDataInterviewer.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).SetArg(1, []itv.Interviewer{{Id: 2, Name: null.StringFrom("Bishop Jones")}}).Return(1, nil).AnyTimes()
+
+	// a full page then a short one, the export stops once a page comes back short
+	start := time.Date(2025, 12, 7, 9, 0, 0, 0, time.UTC)
+	full := make([]TdDate, exportPageSize)
+	for i := range full {
+		full[i] = TdDate{Id: i + 1, DateValue: null.TimeFrom(start), EndValue: null.TimeFrom(start.Add(15 * time.Minute))}
+	}
+	last := []TdDate{{Id: 501, InterviewerId: 2, DateValue: null.TimeFrom(start), EndValue: null.TimeFrom(start.Add(15 * time.Minute)), Hold: null.TimeFrom(start), Confirm: null.TimeFrom(start), Name: null.StringFrom("Smith, John")}}
+	gomock.InOrder(
+		mockDataTdDate.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, page *[]TdDate, param TdDateParam) (int, error) {
+			assert.Equal(t, "date_value DESC, id ASC", param.Sort)
+			assert.Equal(t, "LIMIT 0, 500", param.PaginationString)
+			*page = full
+			return 501, nil
+		}),
+		mockDataTdDate.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, page *[]TdDate, param TdDateParam) (int, error) {
+			assert.Equal(t, "LIMIT 500, 500", param.PaginationString)
+			*page = last
+			return 501, nil
+		}),
+	)
+
+	m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataInterviewerV1: mockDataInterviewer}
+	req := ExportRequest{Columns: []string{"id", "time", "interviewer", "status", "name"}}
+	req.Search.Sort = "-date_value"
+	buf := &bytes.Buffer{}
+	assert.Nil(t, m.ExportCSV(ctx, buf, req))
+	rows, err := csv.NewReader(buf).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, 502, len(rows))
+	assert.Equal(t, []string{"Id", "Time", "Interviewer", "Status", "Name"}, rows[0])
+	assert.Equal(t, []string{"1", "09:00 AM - 09:15 AM", "", "open", ""}, rows[1])
+	assert.Equal(t, []string{"501", "09:00 AM - 09:15 AM", "Bishop Jones", "confirmed", "Smith, John"}, rows[501])
+
+	// nothing is written for a column that can't be exported
+	buf.Reset()
+	err = m.ExportCSV(ctx, buf, ExportRequest{Columns: []string{"name", "manage_token"}})
+	assert.NotNil(t, err)
+	assert.Equal(t, 0, buf.Len())
+}
+
+func TestDomainTdDateV1_Agenda(t *testing.T) {
+	ctx := context.TODO()
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "America/Denver"
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+	mockDataInterviewer := itv.NewMockDataInterviewerV1Adapter(ctrl)
+	mockDataInterviewer.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+
+	// 02:00 UTC on the 8th is the evening of the 7th in Denver
+	booked := []TdDate{{Id: 1, DateValue: null.TimeFrom(time.Date(2025, 12, 8, 2, 0, 0, 0, time.UTC)), Confirm: null.TimeFrom(time.Now()), Name: null.StringFrom("Smith <Family>")}}
+	mockDataTdDate.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).SetArg(1, booked).Return(1, nil).Times(1)
+
+	m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataInterviewerV1: mockDataInterviewer}
+	buf := &bytes.Buffer{}
+	assert.Nil(t, m.Agenda(ctx, buf, AgendaRequest{From: "2025-12-07", To: "2025-12-08"}))
+	out := buf.String()
+	sunday := strings.Index(out, "Sunday, December 7, 2025")
+	monday := strings.Index(out, "Monday, December 8, 2025")
+	smith := strings.Index(out, "Smith &lt;Family&gt;")
+	assert.True(t, sunday > 0 && smith > sunday && monday > smith, "DomainTdDateV1.Agenda() => the booking should be on Sunday's page: %s", out)
+	assert.Contains(t, out[monday:], "No declarations booked.")
+
+	assert.NotNil(t, m.Agenda(ctx, buf, AgendaRequest{From: "2025-12-08", To: "2025-12-07"}), "DomainTdDateV1.Agenda() => To before From")
+	assert.NotNil(t, m.Agenda(ctx, buf, AgendaRequest{From: "2025-12-01", To: "2026-01-31"}), "DomainTdDateV1.Agenda() => too many days")
+}
+
+func TestDomainTdDateV1_ExportCSVFormula(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+	mockDataInterviewer := itv.NewMockDataInterviewerV1Adapter(ctrl)
+	mockDataInterviewer.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+	booked := TdDate{Id: 1, Name: null.StringFrom(`=HYPERLINK("http://example.com","Smith")`), Email: null.StringFrom("@smith@example.com"), Phone: null.StringFrom("+18015550123")}
+	mockDataTdDate.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).SetArg(1, []TdDate{booked}).Return(1, nil)
+
+	m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataInterviewerV1: mockDataInterviewer}
+	buf := &bytes.Buffer{}
+	assert.Nil(t, m.ExportCSV(ctx, buf, ExportRequest{Columns: []string{"id", "name", "email", "phone"}}))
+	rows, err := csv.NewReader(buf).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"1", `'=HYPERLINK("http://example.com","Smith")`, "'@smith@example.com", "'+18015550123"}, rows[1])
+}
+
+func TestCsvSafe(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{"plain", "Smith Family", "Smith Family"},
+		{"empty", "", ""},
+		{"equals", "=1+1", "'=1+1"},
+		{"plus", "+1", "'+1"},
+		{"minus", "-1", "'-1"},
+		{"at", "@SUM(A1)", "'@SUM(A1)"},
+		{"tab", "\t=1", "'\t=1"},
+		{"carriage return", "\r=1", "'\r=1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, csvSafe(tt.value), "csvSafe().%s => unexpected result", tt.name)
+		})
+	}
+}
+
+func TestExportSort(t *testing.T) {
+	tests := []struct {
+		name string
+		sort string
+		want string
+	}{
+		{"default", "", "date_value,id"},
+		{"id added", "-date_value,name", "-date_value,name,id"},
+		{"id already there", "-id", "-id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, exportSort(tt.sort), "exportSort().%s => unexpected result", tt.name)
+		})
+	}
+}
@@ -102,10 +102,67 @@ func (a *TdDateGrpc) DeleteTdDate(ctx context.Context, in *p.TdDateIDIn) (*p.Res
 	return response, nil
 }
 
+func (a *TdDateGrpc) ExpandScheduleTemplate(ctx context.Context, in *p.ExpandTemplateIn) (*p.ExpandTemplateResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.ExpandTemplateResponse{Result: result}
+	expand := &ExpandTemplateRequest{ScheduleTemplateId: int(in.ScheduleTemplateId)}
+	for _, interviewerId := range in.InterviewerIds {
+		expand.InterviewerIds = append(expand.InterviewerIds, int(interviewerId))
+	}
+	err := a.domainTdDate.ExpandTemplate(ctx, expand)
+	response.Created = int64(expand.Created)
+	response.Skipped = int64(expand.Skipped)
+	response.BlackedOut = int64(expand.BlackedOut)
+	if err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	response.Result.Success = true
+	return response, nil
+}
+
+func (a *TdDateGrpc) StatsTdDate(ctx context.Context, in *p.TdDateStatsIn) (*p.TdDateStatsResponse, error) {
+	result := &p.Result{Success: false}
+	response := &p.TdDateStatsResponse{Result: result}
+	stats := &TdDateStats{}
+	if err := a.domainTdDate.Stats(ctx, stats, StatsRequest{From: in.From, To: in.To, SeasonId: int(in.SeasonId)}); err != nil {
+		response.Result.Error = err.Error()
+		return response, err
+	}
+	response.Totals = translateCountsOut(stats.SlotCounts)
+	for _, d := range stats.Days {
+		response.Days = append(response.Days, &p.DayStats{Date: d.Date, Counts: translateCountsOut(d.SlotCounts)})
+	}
+	for _, s := range stats.Seasons {
+		response.Seasons = append(response.Seasons, &p.SeasonStats{SeasonId: s.SeasonId.Int64, Name: s.Name.String, Counts: translateCountsOut(s.SlotCounts)})
+	}
+	for _, w := range stats.Weekdays {
+		response.Weekdays = append(response.Weekdays, &p.WeekdayStats{Weekday: w.Weekday, Counts: translateCountsOut(w.SlotCounts)})
+	}
+	for _, h := range stats.Hours {
+		response.Hours = append(response.Hours, &p.HourStats{Hour: int64(h.Hour), Counts: translateCountsOut(h.SlotCounts)})
+	}
+	response.LeadTime = &p.LeadTime{
+		Bookings:    int64(stats.LeadTime.Bookings),
+		AverageDays: stats.LeadTime.AverageDays,
+		MinDays:     stats.LeadTime.MinDays,
+		MaxDays:     stats.LeadTime.MaxDays,
+	}
+	response.Timezone = stats.Timezone
+	response.Result.Success = true
+	return response, nil
+}
+
+func translateCountsOut(c SlotCounts) *p.SlotCounts {
+	return &p.SlotCounts{Slots: int64(c.Slots), Held: int64(c.Held), Confirmed: int64(c.Confirmed), Open: int64(c.Open), Closed: int64(c.Closed), FillRate: c.FillRate}
+}
+
 func translateOut(td_ *TdDate) (*p.TdDate, error) {
 	protoTdDate := p.TdDate{}
 	protoTdDate.Id = int64(td_.Id)
+	protoTdDate.InterviewerId = int64(td_.InterviewerId)
 	protoTdDate.DateValue = td_.DateValue.Time.Format(time.RFC3339)
+	protoTdDate.EndValue = td_.EndValue.Time.Format(time.RFC3339)
 	protoTdDate.Hold = td_.Hold.Time.Format(time.RFC3339)
 	protoTdDate.Confirm = td_.Confirm.Time.Format(time.RFC3339)
 	protoTdDate.Name = td_.Name.String
@@ -117,7 +174,9 @@ func translateOut(td_ *TdDate) (*p.TdDate, error) {
 func translateIn(in *p.TdDate) (*TdDate, error) {
 	td_ := TdDate{}
 	td_.Id = int(in.Id)
+	td_.InterviewerId = int(in.InterviewerId)
 	td_.DateValue.Scan(in.DateValue)
+	td_.EndValue.Scan(in.EndValue)
 	td_.Hold.Scan(in.Hold)
 	td_.Confirm.Scan(in.Confirm)
 	td_.Name.Scan(in.Name)
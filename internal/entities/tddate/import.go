@@ -0,0 +1,279 @@
+package tddate
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	bod "github.com/blackflagsoftware/tithe-declare/internal/entities/blackoutdate"
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+const importMaxRows = 2000
+
+// the columns a csv can have, slots only use the first four; date and start are required, name is required for bookings
+var importColumns = map[string][]string{
+	ImportSlots:    {"date", "start", "end", "interviewer_id"},
+	ImportBookings: {"date", "start", "end", "interviewer_id", "name", "email", "phone", "sms_opt_in"},
+}
+
+// Import reads a csv of slots or bookings (see importColumns) and saves them in one go, every row is checked the way
+// Post checks a slot and bookings are checked the way Confirm checks the family's details; if any row is wrong
+// or req.DryRun is set nothing is saved, req.Errors says what is wrong with each row
+// imported bookings are saved as confirmed, into the interviewer's free slot at that time if there is one, and the
+// book listeners are told without any emails or texts going out (see WithoutMessages), the families were booked on the old sign-up sheet
+func (m *DomainTdDateV1) Import(ctx context.Context, r io.Reader, req *ImportRequest) error {
+	columns, ok := importColumns[req.Kind]
+	if !ok {
+		return ae.ValidationError([]ae.FieldError{{Field: "kind", Message: "must be one of: slots, bookings"}})
+	}
+	req.Rows = 0
+	req.Created = []int{}
+	req.Errors = []ImportError{}
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+	cr.FieldsPerRecord = -1 // a short row is reported by the missing column rather than the reader
+	header, errHeader := cr.Read()
+	if errHeader != nil {
+		return ae.ParseError("the csv has no header row")
+	}
+	index := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))) // spreadsheets often save a BOM
+		if !slices.Contains(columns, name) {
+			req.Errors = append(req.Errors, ImportError{Line: 1, Field: name, Message: "is not a column for " + req.Kind})
+			continue
+		}
+		index[name] = i
+	}
+	for _, required := range []string{"date", "start"} {
+		if _, ok := index[required]; !ok {
+			req.Errors = append(req.Errors, ImportError{Line: 1, Field: required, Message: "column is required"})
+		}
+	}
+	if req.Kind == ImportBookings {
+		if _, ok := index["name"]; !ok {
+			req.Errors = append(req.Errors, ImportError{Line: 1, Field: "name", Message: "column is required"})
+		}
+	}
+	if len(req.Errors) > 0 {
+		return importErr(req)
+	}
+
+	seasons, err := m.seasons(ctx)
+	if err != nil {
+		return err
+	}
+	interviewers, err := m.interviewerNames(ctx)
+	if err != nil {
+		return err
+	}
+	blackouts, err := m.blackouts(ctx)
+	if err != nil {
+		return err
+	}
+	tz := config.Sch.GetTimezone()
+	now := time.Now().UTC()
+	tdDates := []*TdDate{}
+	seen := make(map[string]int) // interviewer and start => line, a slot can't be in the file twice
+	for {
+		record, errRead := cr.Read()
+		if errors.Is(errRead, io.EOF) {
+			break
+		}
+		if errRead != nil {
+			// the rest of the file can't be trusted
+			line := 0
+			if errParse, ok := errRead.(*csv.ParseError); ok {
+				line = errParse.Line
+			}
+			req.Errors = append(req.Errors, ImportError{Line: line, Message: errRead.Error()})
+			break
+		}
+		line, _ := cr.FieldPos(0)
+		req.Rows++
+		if req.Rows > importMaxRows {
+			return ae.ParseError(fmt.Sprintf("the csv can have at most %d rows", importMaxRows))
+		}
+		value := func(name string) string {
+			if i, ok := index[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		td_, fields := importRow(value, req.Kind, interviewers)
+		if len(fields) == 0 {
+			if errSeason := setSeason(seasons, td_); errSeason != nil {
+				fields = append(fields, ae.FieldError{Field: "date", Message: errSeason.(ae.ApiError).Detail})
+			} else if blackout, ok := bod.For(blackouts, td_.DateValue.Time, tz); ok {
+				fields = append(fields, ae.FieldError{Field: "date", Message: "is closed for: " + blackout.Name.String})
+			}
+		}
+		if len(fields) == 0 {
+			key := fmt.Sprintf("%d %s", td_.InterviewerId, td_.DateValue.Time.Format(time.RFC3339))
+			if first, ok := seen[key]; ok {
+				fields = append(fields, ae.FieldError{Field: "start", Message: fmt.Sprintf("is the same slot as line %d", first)})
+			} else if req.Kind == ImportBookings {
+				// a booking goes into the interviewer's free slot at that time if there is one, i.e.: the slots were imported first
+				slot, found, errSlot := m.slotAt(ctx, td_.InterviewerId, td_.DateValue.Time)
+				if errSlot != nil {
+					return errSlot
+				}
+				if found && (slot.Hold.Valid || slot.Confirm.Valid) {
+					fields = append(fields, ae.FieldError{Field: "start", Message: "is already booked"})
+				}
+				if found {
+					td_.Id = slot.Id // left at 0 => no slot yet, one is made
+					td_.EndValue = slot.EndValue
+				}
+			} else if exists, errExists := m.dataTdDateV1.Exists(ctx, td_.InterviewerId, td_.DateValue.Time); errExists != nil {
+				return errExists
+			} else if exists {
+				fields = append(fields, ae.FieldError{Field: "start", Message: "is already a slot for this interviewer"})
+			}
+			seen[key] = line
+		}
+		for _, f := range fields {
+			req.Errors = append(req.Errors, ImportError{Line: line, Field: f.Field, Message: f.Message})
+		}
+		if req.Kind == ImportBookings {
+			td_.Hold = null.TimeFrom(now)
+			td_.Confirm = null.TimeFrom(now)
+			td_.ManageToken = null.StringFrom(newManageToken())
+		}
+		tdDates = append(tdDates, td_)
+	}
+	if req.Rows == 0 && len(req.Errors) == 0 {
+		return ae.ParseError("the csv has no rows")
+	}
+	if len(req.Errors) > 0 {
+		return importErr(req)
+	}
+	if req.DryRun {
+		return nil
+	}
+	existing := make(map[*TdDate]bool) // the bookings that went into a slot already on file
+	for _, td_ := range tdDates {
+		existing[td_] = td_.Id > 0
+	}
+	if err := m.dataTdDateV1.Import(ctx, tdDates); err != nil {
+		return err
+	}
+	// audited and the listeners told in line rather than in the background, the tools/import cli exits as soon as this returns
+	quiet := WithoutMessages(ctx)
+	for _, td_ := range tdDates {
+		req.Created = append(req.Created, td_.Id)
+		if existing[td_] {
+			a.AuditPatch(m.auditWriter, *td_, TdDateConst, a.KeysToString("id", td_.Id), map[string]any{"hold": "", "confirm": ""})
+		} else {
+			a.AuditCreate(m.auditWriter, *td_, TdDateConst, a.KeysToString("id", td_.Id))
+		}
+		if req.Kind == ImportBookings {
+			for _, listener := range m.bookListeners {
+				listener.SlotBooked(quiet, *td_)
+			}
+		}
+	}
+	return nil
+}
+
+// slotAt is the interviewer's slot at start, found is false if there isn't one
+func (m *DomainTdDateV1) slotAt(ctx context.Context, interviewerId int, start time.Time) (TdDate, bool, error) {
+	param := TdDateParam{Param: h.Param{Search: h.Search{Filters: []h.Filter{
+		{Column: "interviewer_id", Compare: "=", Value: interviewerId},
+		{Column: "date_value", Compare: "=", Value: start},
+	}}}}
+	param.Param.CalculateParam("id", map[string]string{"id": "id", "interviewer_id": "interviewer_id", "date_value": "date_value"})
+	tdDates := []TdDate{}
+	if _, err := m.dataTdDateV1.ReadAll(ctx, &tdDates, param); err != nil {
+		return TdDate{}, false, err
+	}
+	if len(tdDates) == 0 {
+		return TdDate{}, false, nil
+	}
+	return tdDates[0], true, nil
+}
+
+// importErr is the row errors as field errors, a dry run reports them in req.Errors only
+func importErr(req *ImportRequest) error {
+	if req.DryRun {
+		return nil
+	}
+	fields := []ae.FieldError{}
+	for _, e := range req.Errors {
+		fields = append(fields, ae.FieldError{Field: fmt.Sprintf("line[%d].%s", e.Line, e.Field), Message: e.Message})
+	}
+	return ae.ValidationError(fields)
+}
+
+// importRow builds the slot from the row's values, the times are in the unit's timezone
+func importRow(value func(string) string, kind string, interviewers map[int]string) (*TdDate, []ae.FieldError) {
+	tz := config.Sch.GetTimezone()
+	td_ := &TdDate{}
+	fields := []ae.FieldError{}
+	date := value("date")
+	start, errStart := parseImportTime(date, value("start"), tz)
+	switch {
+	case date == "":
+		fields = append(fields, ae.FieldError{Field: "date", Message: "is required"})
+	case value("start") == "":
+		fields = append(fields, ae.FieldError{Field: "start", Message: "is required"})
+	case errStart != nil:
+		fields = append(fields, ae.FieldError{Field: "start", Message: "must be a date of YYYY-MM-DD and a time of HH:MM or 03:04 PM"})
+	default:
+		td_.DateValue = null.TimeFrom(start.UTC())
+		td_.EndValue = null.TimeFrom(start.Add(time.Duration(config.Sch.GetSlotDuration()) * time.Minute).UTC())
+	}
+	if end := value("end"); end != "" && td_.DateValue.Valid {
+		endTime, errEnd := parseImportTime(date, end, tz)
+		switch {
+		case errEnd != nil:
+			fields = append(fields, ae.FieldError{Field: "end", Message: "must be a time of HH:MM or 03:04 PM"})
+		case !endTime.After(start):
+			fields = append(fields, ae.FieldError{Field: "end", Message: "must be after start"})
+		default:
+			td_.EndValue = null.TimeFrom(endTime.UTC())
+		}
+	}
+	if interviewerId := value("interviewer_id"); interviewerId != "" {
+		id, errId := strconv.Atoi(interviewerId)
+		if _, ok := interviewers[id]; errId != nil || !ok {
+			fields = append(fields, ae.FieldError{Field: "interviewer_id", Message: "is not an interviewer"})
+		}
+		td_.InterviewerId = id
+	}
+	if kind == ImportBookings {
+		td_.Name = null.NewString(value("name"), value("name") != "")
+		td_.Email = null.NewString(value("email"), value("email") != "")
+		td_.Phone = null.NewString(value("phone"), value("phone") != "")
+		if smsOptIn := value("sms_opt_in"); smsOptIn != "" {
+			optIn, errOptIn := strconv.ParseBool(smsOptIn)
+			if errOptIn != nil {
+				fields = append(fields, ae.FieldError{Field: "sms_opt_in", Message: "must be true or false"})
+			}
+			td_.SmsOptIn = null.BoolFrom(optIn)
+		}
+		if err := validateContact(td_, true); err != nil {
+			fields = append(fields, err.(ae.ApiError).Fields...)
+		}
+	}
+	return td_, fields
+}
+
+func parseImportTime(date, clock string, tz *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation("2006-01-02 15:04", date+" "+clock, tz)
+	if err != nil {
+		return time.ParseInLocation("2006-01-02 03:04 PM", date+" "+strings.ToUpper(clock), tz)
+	}
+	return t, nil
+}
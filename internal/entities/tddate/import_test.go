@@ -0,0 +1,171 @@
+package tddate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	bod "github.com/blackflagsoftware/tithe-declare/internal/entities/blackoutdate"
+	itv "github.com/blackflagsoftware/tithe-declare/internal/entities/interviewer"
+	ssn "github.com/blackflagsoftware/tithe-declare/internal/entities/season"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestDomainTdDateV1_Import(t *testing.T) {
+	ctx := context.TODO()
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "America/Denver"
+
+	taken := time.Date(2025, 12, 7, 17, 0, 0, 0, time.UTC) // 10:00 AM in Denver
+	christmas := bod.BlackoutDate{Id: 1, Name: null.StringFrom("Christmas"), StartDate: null.StringFrom("2025-12-25"), EndDate: null.StringFrom("2025-12-25")}
+	archived := ssn.Season{Id: 1, Name: null.StringFrom("2024"), OpenDate: null.StringFrom("2024-12-01"), CloseDate: null.StringFrom("2024-12-31"), Archived: null.BoolFrom(true)}
+	bookings := "date,start,end,interviewer_id,name,email,phone\n" +
+		"2025-12-07,09:00,09:15,1,Smith Family,Smith@Example.com,\n" +
+		"2025-12-07,09:15 am,,1,Jones Family,,801-555-0101\n"
+	tests := []struct {
+		name       string
+		kind       string
+		csv        string
+		dryRun     bool
+		wantErr    bool
+		wantSaved  int
+		wantErrors []ImportError
+	}{
+		{"successful - bookings", ImportBookings, bookings, false, false, 2, nil},
+		{"successful - dry run", ImportBookings, bookings, true, false, 0, nil},
+		{"successful - slots", ImportSlots, "start,date\n14:00,2025-12-07\n", false, false, 1, nil},
+		{
+			"failed - rows",
+			ImportBookings,
+			"date,start,end,interviewer_id,name,email\n" +
+				"2025-12-07,9am,,,Smith Family,\n" +
+				"2025-12-07,09:00,08:00,,Smith Family,\n" +
+				"2025-12-07,09:00,,9,,not-an-email\n" +
+				"2025-12-07,10:00,,,Brown Family,\n" +
+				"2024-12-07,10:00,,,Brown Family,\n" +
+				"2025-12-25,10:00,,,Brown Family,\n",
+			false,
+			true,
+			0,
+			[]ImportError{
+				{Line: 2, Field: "start"},
+				{Line: 3, Field: "end"},
+				{Line: 4, Field: "interviewer_id"},
+				{Line: 4, Field: "name"},
+				{Line: 4, Field: "email"},
+				{Line: 5, Field: "start"},
+				{Line: 6, Field: "date"},
+				{Line: 7, Field: "date"},
+			},
+		},
+		{"failed - dry run reports", ImportSlots, "date,start\n2025-12-07,09:00\n2025-12-07,09:00\n", true, false, 0, []ImportError{{Line: 3, Field: "start"}}},
+		{"failed - header", ImportSlots, "date,start,name\n2025-12-07,09:00,Smith\n", false, true, 0, []ImportError{{Line: 1, Field: "name"}}},
+		{"failed - bookings need a name", ImportBookings, "date,start\n2025-12-07,09:00\n", false, true, 0, []ImportError{{Line: 1, Field: "name"}}},
+		{"failed - kind", "people", bookings, false, true, 0, nil},
+		{"failed - no rows", ImportSlots, "date,start\n", false, true, 0, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+			mockDataInterviewer := itv.NewMockDataInterviewerV1Adapter(ctrl)
+			mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+			mockDataInterviewer.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).SetArg(1, []itv.Interviewer{{Id: 1, Name: null.StringFrom("Bishop Jones")}}).Return(1, nil).AnyTimes()
+			mockDataSeason.EXPECT().List(ctx, gomock.Any()).SetArg(1, []ssn.Season{archived}).Return(nil).AnyTimes()
+			mockDataBlackoutDate := bod.NewMockDataBlackoutDateV1Adapter(ctrl)
+			mockDataBlackoutDate.EXPECT().List(ctx, gomock.Any()).SetArg(1, []bod.BlackoutDate{christmas}).Return(nil).AnyTimes()
+			mockDataTdDate.EXPECT().Exists(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ int, dt time.Time) (bool, error) {
+				return dt.Equal(taken), nil
+			}).AnyTimes()
+			mockDataTdDate.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tdDates *[]TdDate, param TdDateParam) (int, error) {
+				if dt := param.Search.Filters[1].Value.(time.Time); dt.Equal(taken) {
+					*tdDates = []TdDate{{Id: 5, DateValue: null.TimeFrom(taken), Hold: null.TimeFrom(taken), Confirm: null.TimeFrom(taken)}}
+				}
+				return len(*tdDates), nil
+			}).AnyTimes()
+			var saved []*TdDate
+			mockDataTdDate.EXPECT().Import(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tdDates []*TdDate) error {
+				for i, td_ := range tdDates {
+					if td_.Id == 0 {
+						td_.Id = i + 10
+					}
+				}
+				saved = tdDates
+				return nil
+			}).MaxTimes(1)
+
+			m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataInterviewerV1: mockDataInterviewer, dataSeasonV1: mockDataSeason, dataBlackoutDateV1: mockDataBlackoutDate}
+			req := &ImportRequest{Kind: tt.kind, DryRun: tt.dryRun}
+			err := m.Import(ctx, strings.NewReader(tt.csv), req)
+			assert.Equal(t, tt.wantErr, err != nil, "DomainTdDateV1.Import().%s => expected error: got: %s", tt.name, err)
+			assert.Equal(t, tt.wantSaved, len(saved))
+			assert.Equal(t, tt.wantSaved, len(req.Created))
+			gotErrors := []ImportError{}
+			for _, e := range req.Errors {
+				gotErrors = append(gotErrors, ImportError{Line: e.Line, Field: e.Field})
+			}
+			if tt.wantErrors == nil {
+				tt.wantErrors = []ImportError{}
+			}
+			assert.Equal(t, tt.wantErrors, gotErrors, "DomainTdDateV1.Import().%s => unexpected row errors: %v", tt.name, req.Errors)
+		})
+	}
+}
+
+func TestDomainTdDateV1_ImportBooking(t *testing.T) {
+	ctx := context.TODO()
+	defer func(tz string) { config.Sch.Timezone = tz }(config.Sch.Timezone)
+	config.Sch.Timezone = "America/Denver"
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+	mockDataInterviewer := itv.NewMockDataInterviewerV1Adapter(ctrl)
+	mockDataSeason := ssn.NewMockDataSeasonV1Adapter(ctrl)
+	mockDataInterviewer.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).Return(0, nil).AnyTimes()
+	mockDataSeason.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+	mockDataBlackoutDate := bod.NewMockDataBlackoutDateV1Adapter(ctrl)
+	mockDataBlackoutDate.EXPECT().List(ctx, gomock.Any()).Return(nil).AnyTimes()
+	// the slot at 09:00 was imported first, the booking at 09:30 has no slot yet
+	free := TdDate{Id: 7, DateValue: null.TimeFrom(time.Date(2025, 12, 7, 16, 0, 0, 0, time.UTC)), EndValue: null.TimeFrom(time.Date(2025, 12, 7, 16, 20, 0, 0, time.UTC))}
+	mockDataTdDate.EXPECT().ReadAll(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, tdDates *[]TdDate, param TdDateParam) (int, error) {
+		if dt := param.Search.Filters[1].Value.(time.Time); dt.Equal(free.DateValue.Time) {
+			*tdDates = []TdDate{free}
+		}
+		return len(*tdDates), nil
+	}).Times(2)
+	mockDataTdDate.EXPECT().Import(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tdDates []*TdDate) error {
+		assert.Equal(t, 2, len(tdDates))
+		td_ := tdDates[0]
+		assert.Equal(t, free.Id, td_.Id, "a booking goes into the free slot")
+		assert.Equal(t, free.EndValue.Time, td_.EndValue.Time, "the free slot keeps its end")
+		assert.Equal(t, "Smith@Example.com", td_.Email.String)
+		assert.True(t, td_.SmsOptIn.Bool)
+		assert.True(t, td_.Hold.Valid && td_.Confirm.Valid, "an imported booking is confirmed")
+		assert.True(t, td_.ManageToken.Valid, "an imported booking can be managed by the family")
+		td_ = tdDates[1]
+		assert.Equal(t, 0, td_.Id, "a booking without a slot makes one")
+		assert.Equal(t, time.Date(2025, 12, 7, 16, 30, 0, 0, time.UTC), td_.DateValue.Time)
+		assert.Equal(t, time.Date(2025, 12, 7, 17, 0, 0, 0, time.UTC), td_.EndValue.Time)
+		td_.Id = 8
+		return nil
+	}).Times(1)
+	mockBookListener := NewMockSlotBookListener(ctrl)
+	booked := []int{}
+	mockBookListener.EXPECT().SlotBooked(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, td_ TdDate) {
+		assert.True(t, Quiet(ctx), "no emails or texts for an imported booking")
+		booked = append(booked, td_.Id)
+	}).Times(2)
+
+	m := &DomainTdDateV1{dataTdDateV1: mockDataTdDate, dataInterviewerV1: mockDataInterviewer, dataSeasonV1: mockDataSeason, dataBlackoutDateV1: mockDataBlackoutDate}
+	m.AddSlotBookListener(mockBookListener)
+	csv := "\ufeffDate,Start,End,Name,Email,Phone,SMS_Opt_In\n" +
+		"2025-12-07,09:00 AM,,Smith Family, Smith@Example.com ,801-555-0100,true\n" +
+		"2025-12-07,09:30 AM,10:00 AM,Jones Family,,801-555-0101,\n"
+	req := &ImportRequest{Kind: ImportBookings}
+	assert.Nil(t, m.Import(ctx, strings.NewReader(csv), req))
+	assert.Equal(t, []int{7, 8}, req.Created)
+	assert.Equal(t, []int{7, 8}, booked, "the book listeners are told about every imported booking")
+}
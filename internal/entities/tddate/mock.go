@@ -35,8 +35,22 @@ func (m *MockDataTdDateV1Adapter) EXPECT() *MockDataTdDateV1AdapterMockRecorder
 	return m.recorder
 }
 
+// ChangeRange mocks base method.
+func (m *MockDataTdDateV1Adapter) ChangeRange(arg0 context.Context, arg1 SlotRange, arg2, arg3 *[]TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeRange", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeRange indicates an expected call of ChangeRange.
+func (mr *MockDataTdDateV1AdapterMockRecorder) ChangeRange(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeRange", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).ChangeRange), arg0, arg1, arg2, arg3)
+}
+
 // CheckSetHoldTime mocks base method.
-func (m *MockDataTdDateV1Adapter) CheckSetHoldTime(arg0 context.Context, arg1 time.Time) error {
+func (m *MockDataTdDateV1Adapter) CheckSetHoldTime(arg0 context.Context, arg1 *TdDate) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CheckSetHoldTime", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -50,7 +64,7 @@ func (mr *MockDataTdDateV1AdapterMockRecorder) CheckSetHoldTime(arg0, arg1 inter
 }
 
 // Confirm mocks base method.
-func (m *MockDataTdDateV1Adapter) Confirm(arg0 context.Context, arg1 TdDate) error {
+func (m *MockDataTdDateV1Adapter) Confirm(arg0 context.Context, arg1 *TdDate) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Confirm", arg0, arg1)
 	ret0, _ := ret[0].(error)
@@ -91,8 +105,23 @@ func (mr *MockDataTdDateV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).Delete), arg0, arg1)
 }
 
+// Exists mocks base method.
+func (m *MockDataTdDateV1Adapter) Exists(arg0 context.Context, arg1 int, arg2 time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockDataTdDateV1AdapterMockRecorder) Exists(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).Exists), arg0, arg1, arg2)
+}
+
 // GetCurrentDays mocks base method.
-func (m *MockDataTdDateV1Adapter) GetCurrentDays(arg0 context.Context, arg1 *[]time.Time, arg2 TdDateParam) error {
+func (m *MockDataTdDateV1Adapter) GetCurrentDays(arg0 context.Context, arg1 *[]TdDate, arg2 TdDateParam) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCurrentDays", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
@@ -105,6 +134,34 @@ func (mr *MockDataTdDateV1AdapterMockRecorder) GetCurrentDays(arg0, arg1, arg2 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentDays", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).GetCurrentDays), arg0, arg1, arg2)
 }
 
+// Import mocks base method.
+func (m *MockDataTdDateV1Adapter) Import(arg0 context.Context, arg1 []*TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockDataTdDateV1AdapterMockRecorder) Import(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).Import), arg0, arg1)
+}
+
+// NextHoldExpiry mocks base method.
+func (m *MockDataTdDateV1Adapter) NextHoldExpiry(arg0 context.Context, arg1 *TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextHoldExpiry", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NextHoldExpiry indicates an expected call of NextHoldExpiry.
+func (mr *MockDataTdDateV1AdapterMockRecorder) NextHoldExpiry(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextHoldExpiry", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).NextHoldExpiry), arg0, arg1)
+}
+
 // Read mocks base method.
 func (m *MockDataTdDateV1Adapter) Read(arg0 context.Context, arg1 *TdDate) error {
 	m.ctrl.T.Helper()
@@ -134,6 +191,104 @@ func (mr *MockDataTdDateV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
 }
 
+// ReadByHoldToken mocks base method.
+func (m *MockDataTdDateV1Adapter) ReadByHoldToken(arg0 context.Context, arg1 *TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadByHoldToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadByHoldToken indicates an expected call of ReadByHoldToken.
+func (mr *MockDataTdDateV1AdapterMockRecorder) ReadByHoldToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByHoldToken", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).ReadByHoldToken), arg0, arg1)
+}
+
+// ReadByManageToken mocks base method.
+func (m *MockDataTdDateV1Adapter) ReadByManageToken(arg0 context.Context, arg1 *TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadByManageToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadByManageToken indicates an expected call of ReadByManageToken.
+func (mr *MockDataTdDateV1AdapterMockRecorder) ReadByManageToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByManageToken", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).ReadByManageToken), arg0, arg1)
+}
+
+// ReleaseExpiredHolds mocks base method.
+func (m *MockDataTdDateV1Adapter) ReleaseExpiredHolds(arg0 context.Context, arg1, arg2 time.Time, arg3 *[]TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseExpiredHolds", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseExpiredHolds indicates an expected call of ReleaseExpiredHolds.
+func (mr *MockDataTdDateV1AdapterMockRecorder) ReleaseExpiredHolds(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseExpiredHolds", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).ReleaseExpiredHolds), arg0, arg1, arg2, arg3)
+}
+
+// Reschedule mocks base method.
+func (m *MockDataTdDateV1Adapter) Reschedule(arg0 context.Context, arg1 TdDate, arg2 *TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reschedule", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reschedule indicates an expected call of Reschedule.
+func (mr *MockDataTdDateV1AdapterMockRecorder) Reschedule(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reschedule", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).Reschedule), arg0, arg1, arg2)
+}
+
+// SetHousehold mocks base method.
+func (m *MockDataTdDateV1Adapter) SetHousehold(arg0 context.Context, arg1 TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHousehold", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHousehold indicates an expected call of SetHousehold.
+func (mr *MockDataTdDateV1AdapterMockRecorder) SetHousehold(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHousehold", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).SetHousehold), arg0, arg1)
+}
+
+// SetOutcome mocks base method.
+func (m *MockDataTdDateV1Adapter) SetOutcome(arg0 context.Context, arg1 TdDate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOutcome", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOutcome indicates an expected call of SetOutcome.
+func (mr *MockDataTdDateV1AdapterMockRecorder) SetOutcome(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOutcome", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).SetOutcome), arg0, arg1)
+}
+
+// Stats mocks base method.
+func (m *MockDataTdDateV1Adapter) Stats(arg0 context.Context, arg1 *StatsAggregate, arg2 TdDateParam) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockDataTdDateV1AdapterMockRecorder) Stats(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).Stats), arg0, arg1, arg2)
+}
+
 // Update mocks base method.
 func (m *MockDataTdDateV1Adapter) Update(arg0 context.Context, arg1 TdDate) error {
 	m.ctrl.T.Helper()
@@ -147,3 +302,108 @@ func (mr *MockDataTdDateV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataTdDateV1Adapter)(nil).Update), arg0, arg1)
 }
+
+// MockSlotReleaseListener is a mock of SlotReleaseListener interface.
+type MockSlotReleaseListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotReleaseListenerMockRecorder
+}
+
+// MockSlotReleaseListenerMockRecorder is the mock recorder for MockSlotReleaseListener.
+type MockSlotReleaseListenerMockRecorder struct {
+	mock *MockSlotReleaseListener
+}
+
+// NewMockSlotReleaseListener creates a new mock instance.
+func NewMockSlotReleaseListener(ctrl *gomock.Controller) *MockSlotReleaseListener {
+	mock := &MockSlotReleaseListener{ctrl: ctrl}
+	mock.recorder = &MockSlotReleaseListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotReleaseListener) EXPECT() *MockSlotReleaseListenerMockRecorder {
+	return m.recorder
+}
+
+// SlotReleased mocks base method.
+func (m *MockSlotReleaseListener) SlotReleased(arg0 context.Context, arg1 TdDate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SlotReleased", arg0, arg1)
+}
+
+// SlotReleased indicates an expected call of SlotReleased.
+func (mr *MockSlotReleaseListenerMockRecorder) SlotReleased(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlotReleased", reflect.TypeOf((*MockSlotReleaseListener)(nil).SlotReleased), arg0, arg1)
+}
+
+// MockSlotBookListener is a mock of SlotBookListener interface.
+type MockSlotBookListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotBookListenerMockRecorder
+}
+
+// MockSlotBookListenerMockRecorder is the mock recorder for MockSlotBookListener.
+type MockSlotBookListenerMockRecorder struct {
+	mock *MockSlotBookListener
+}
+
+// NewMockSlotBookListener creates a new mock instance.
+func NewMockSlotBookListener(ctrl *gomock.Controller) *MockSlotBookListener {
+	mock := &MockSlotBookListener{ctrl: ctrl}
+	mock.recorder = &MockSlotBookListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotBookListener) EXPECT() *MockSlotBookListenerMockRecorder {
+	return m.recorder
+}
+
+// SlotBooked mocks base method.
+func (m *MockSlotBookListener) SlotBooked(arg0 context.Context, arg1 TdDate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SlotBooked", arg0, arg1)
+}
+
+// SlotBooked indicates an expected call of SlotBooked.
+func (mr *MockSlotBookListenerMockRecorder) SlotBooked(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlotBooked", reflect.TypeOf((*MockSlotBookListener)(nil).SlotBooked), arg0, arg1)
+}
+
+// MockNoShowListener is a mock of NoShowListener interface.
+type MockNoShowListener struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoShowListenerMockRecorder
+}
+
+// MockNoShowListenerMockRecorder is the mock recorder for MockNoShowListener.
+type MockNoShowListenerMockRecorder struct {
+	mock *MockNoShowListener
+}
+
+// NewMockNoShowListener creates a new mock instance.
+func NewMockNoShowListener(ctrl *gomock.Controller) *MockNoShowListener {
+	mock := &MockNoShowListener{ctrl: ctrl}
+	mock.recorder = &MockNoShowListenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoShowListener) EXPECT() *MockNoShowListenerMockRecorder {
+	return m.recorder
+}
+
+// NoShow mocks base method.
+func (m *MockNoShowListener) NoShow(arg0 context.Context, arg1 TdDate) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "NoShow", arg0, arg1)
+}
+
+// NoShow indicates an expected call of NoShow.
+func (mr *MockNoShowListenerMockRecorder) NoShow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NoShow", reflect.TypeOf((*MockNoShowListener)(nil).NoShow), arg0, arg1)
+}
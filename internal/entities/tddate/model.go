@@ -1,19 +1,35 @@
 package tddate
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
 	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/ics"
 	"gopkg.in/guregu/null.v3"
 )
 
 type (
 	TdDate struct {
-		Id        int         `db:"id" json:"id"`
-		DateValue null.Time   `db:"date_value" json:"date_value"`
-		Hold      null.Time   `db:"hold" json:"hold"`
-		Confirm   null.Time   `db:"confirm" json:"confirm"`
-		Name      null.String `db:"name" json:"name"`
-		Phone     null.String `db:"phone" json:"phone"`
-		Email     null.String `db:"email" json:"email"`
+		Id            int         `db:"id" json:"id"`
+		InterviewerId int         `db:"interviewer_id" json:"interviewer_id"` // 0 => unassigned
+		DateValue     null.Time   `db:"date_value" json:"date_value"`
+		EndValue      null.Time   `db:"end_value" json:"end_value"`
+		Hold          null.Time   `db:"hold" json:"hold"`
+		Confirm       null.Time   `db:"confirm" json:"confirm"`
+		Name          null.String `db:"name" json:"name"`
+		Phone         null.String `db:"phone" json:"phone"`
+		Email         null.String `db:"email" json:"email"`
+		SmsOptIn      null.Bool   `db:"sms_opt_in" json:"sms_opt_in"`     // texts the confirmation and reminders to phone
+		HouseholdId   null.Int    `db:"household_id" json:"household_id"` // matched by email or phone once confirmed
+		SeasonId      null.Int    `db:"season_id" json:"season_id"`       // set from the season the date_value falls in
+		Outcome       null.String `db:"outcome" json:"outcome"`           // attended, no-show or rescheduled, set by the clerk afterwards
+		OutcomeAt     null.Time   `db:"outcome_at" json:"outcome_at"`
+		ClosedAt      null.Time   `db:"closed_at" json:"closed_at"`   // a closed slot stays on file but isn't offered or booked
+		ManageToken   null.String `db:"manage_token" json:"-"`        // only ever sent to the family
+		HoldToken     null.String `db:"hold_token" json:"-"`          // only ever sent to whoever placed the hold
+		ExpiresAt     null.Time   `db:"expires_at" json:"expires_at"` // when an unconfirmed hold is released
 	}
 
 	TdDateParam struct {
@@ -22,27 +38,267 @@ type (
 	}
 
 	TdDateBlock struct {
-		NewDate   null.String `json:"new_date"`
-		StartTime null.String `json:"start_time"`
-		EndTime   null.String `json:"end_time"`
+		NewDate        null.String `json:"new_date"`
+		StartTime      null.String `json:"start_time"`
+		EndTime        null.String `json:"end_time"`
+		SlotDuration   null.Int    `json:"slot_duration"`   // in minutes, defaults to config
+		BufferTime     null.Int    `json:"buffer_time"`     // in minutes, defaults to config
+		InterviewerIds []int       `json:"interviewer_ids"` // one slot per interviewer, empty => unassigned
+	}
+
+	ExpandTemplateRequest struct {
+		ScheduleTemplateId int   `json:"schedule_template_id"`
+		InterviewerIds     []int `json:"interviewer_ids"`
+		Created            int   `json:"created"`
+		Skipped            int   `json:"skipped"`
+		BlackedOut         int   `json:"blacked_out"` // returned, slots not made because the day is a blackout date
 	}
 
 	CurrentDateTime struct {
-		DayAndTimes map[string][]string `json:"day_and_times"`
+		DayAndTimes  map[string][]string       `json:"day_and_times"`
+		Interviewers []InterviewerAvailability `json:"interviewers"`
+		Timezone     string                    `json:"timezone"` // the times above are in this timezone
+	}
+
+	// InterviewerAvailability is the open times for a single interviewer, grouped by day
+	InterviewerAvailability struct {
+		InterviewerId int                 `json:"interviewer_id"`
+		Name          string              `json:"name"`
+		DayAndTimes   map[string][]string `json:"day_and_times"`
 	}
 
 	CheckHoldTimeRequest struct {
-		Date string `json:"date"`
-		Time string `json:"time"`
+		Date          string    `json:"date"`
+		Time          string    `json:"time"`
+		InterviewerId int       `json:"interviewer_id"` // optional, 0 => any free interviewer
+		HoldToken     string    `json:"hold_token"`     // returned once the hold is placed
+		ExpiresAt     null.Time `json:"expires_at"`     // returned once the hold is placed
 	}
 
+	HoldRemaining struct {
+		HoldToken        string    `json:"hold_token"`
+		ExpiresAt        null.Time `json:"expires_at"`
+		RemainingSeconds int       `json:"remaining_seconds"`
+	}
+
+	// the hold_token returned from CheckSetHoldTime picks the slot to confirm
 	ConfirmRequest struct {
 		TdDate
-		CheckHoldTimeRequest
+		HoldToken string `json:"hold_token"`
+	}
+
+	// AttendanceRequest marks how the day's bookings went, Rest is given to the bookings not in Outcomes
+	AttendanceRequest struct {
+		Date     string              `json:"date"` // YYYY-MM-DD in the unit's timezone, defaults to today
+		Outcomes []AttendanceOutcome `json:"outcomes"`
+		Rest     null.String         `json:"rest"`     // optional, e.g.: attended; not given => the others are left as is
+		Marked   int                 `json:"marked"`   // returned, bookings changed
+		NoShows  int                 `json:"no_shows"` // returned, bookings newly marked no-show
+	}
+
+	AttendanceOutcome struct {
+		Id      int    `json:"id"`
+		Outcome string `json:"outcome"`
+	}
+
+	// ExportRequest is a search (filters and sort as in /td-date/search) and the columns to export
+	ExportRequest struct {
+		TdDateParam
+		Columns []string `json:"columns"` // see exportColumns, empty => all of them
+	}
+
+	// AgendaRequest picks the days of the printable agenda
+	AgendaRequest struct {
+		From string `json:"from"` // YYYY-MM-DD in the unit's timezone, defaults to today
+		To   string `json:"to"`   // YYYY-MM-DD in the unit's timezone, inclusive, defaults to From
+	}
+
+	// ImportRequest is how to read a csv of slots or bookings (see importColumns) and what came of it
+	ImportRequest struct {
+		Kind    string        `json:"kind"`    // slots or bookings
+		DryRun  bool          `json:"dry_run"` // only validate, nothing is saved
+		Rows    int           `json:"rows"`    // returned, rows read (the header isn't counted)
+		Created []int         `json:"created"` // returned, ids of the slots saved
+		Errors  []ImportError `json:"errors"`  // returned, nothing is saved if there are any
+	}
+
+	// SlotRangeRequest closes, reopens or deletes the unbooked slots in a window in one go, e.g.: a Sunday cancelled for conference
+	// the confirmed bookings in the window are left as they are and reported in Booked
+	SlotRangeRequest struct {
+		Action        string   `json:"action"`         // close, open or delete
+		From          string   `json:"from"`           // YYYY-MM-DD or YYYY-MM-DD HH:MM in the unit's timezone
+		To            string   `json:"to"`             // YYYY-MM-DD (the whole day) or YYYY-MM-DD HH:MM (exclusive), defaults to the end of From's day
+		InterviewerId int      `json:"interviewer_id"` // optional, 0 => every interviewer
+		NotifyBooked  bool     `json:"notify_booked"`  // email the booked families asking them to reschedule
+		Changed       []int    `json:"changed"`        // returned, ids of the slots closed, opened or deleted
+		Booked        []TdDate `json:"booked"`         // returned, confirmed bookings in the window
+		Notified      int      `json:"notified"`       // returned, booked families emailed
+	}
+
+	// SlotRange is the window of a SlotRangeRequest for storage, From <= date_value < To
+	SlotRange struct {
+		Action        string
+		InterviewerId int
+		From          time.Time
+		To            time.Time
+		ClosedAt      time.Time
+	}
+
+	ImportError struct {
+		Line    int    `json:"line"` // line in the csv, the header is line 1
+		Field   string `json:"field"`
+		Message string `json:"message"`
+	}
+
+	// StatsRequest picks the slots counted in TdDateStats, nothing given => all of them
+	StatsRequest struct {
+		From     string `json:"from"` // YYYY-MM-DD in the unit's timezone, inclusive
+		To       string `json:"to"`   // YYYY-MM-DD in the unit's timezone, inclusive
+		SeasonId int    `json:"season_id"`
+	}
+
+	// SlotCounts is how the slots in a group stand, held => held but not yet confirmed; closed slots aren't open
+	SlotCounts struct {
+		Slots     int     `db:"slots" json:"slots"`
+		Held      int     `db:"held" json:"held"`
+		Confirmed int     `db:"confirmed" json:"confirmed"`
+		Open      int     `db:"open" json:"open"`
+		Closed    int     `db:"closed" json:"closed"`
+		FillRate  float64 `db:"-" json:"fill_rate"` // confirmed / slots
+	}
+
+	// TdDateStats is the admin dashboard, days, weekdays and hours are in the unit's timezone
+	TdDateStats struct {
+		SlotCounts
+		Days     []DayStats     `json:"days"`
+		Seasons  []SeasonStats  `json:"seasons"`
+		Weekdays []WeekdayStats `json:"weekdays"`
+		Hours    []HourStats    `json:"hours"`
+		LeadTime LeadTime       `json:"lead_time"`
+		Timezone string         `json:"timezone"`
+	}
+
+	DayStats struct {
+		Date string `json:"date"` // YYYY-MM-DD
+		SlotCounts
+	}
+
+	SeasonStats struct {
+		SeasonId null.Int    `db:"season_id" json:"season_id"` // null => slots outside any season
+		Name     null.String `db:"name" json:"name"`
+		SlotCounts
 	}
+
+	WeekdayStats struct {
+		Weekday string `json:"weekday"` // e.g.: Sunday
+		SlotCounts
+	}
+
+	HourStats struct {
+		Hour int `json:"hour"` // 0 - 23
+		SlotCounts
+	}
+
+	// LeadTime is how far ahead of the appointment families confirm, in days
+	LeadTime struct {
+		Bookings    int     `db:"bookings" json:"bookings"`
+		AverageDays float64 `db:"average_days" json:"average_days"`
+		MinDays     float64 `db:"min_days" json:"min_days"`
+		MaxDays     float64 `db:"max_days" json:"max_days"`
+	}
+
+	// StatsStartCounts is the slot counts of every start time (UTC), the domain folds them into days, weekdays and hours
+	StatsStartCounts struct {
+		Start string `db:"start"` // YYYY-MM-DD HH:MM
+		SlotCounts
+	}
+
+	// StatsAggregate is what the storage layer sums up for TdDateStats
+	StatsAggregate struct {
+		Starts   []StatsStartCounts
+		Seasons  []SeasonStats
+		LeadTime LeadTime
+	}
+)
+
+const (
+	TdDateConst       = "td_date"
+	layoutDisplayTime = "03:04 PM"
+	layoutDate        = "2006-01-02"
+	calendarProdId    = "-//blackflagsoftware//tithe-declare//EN"
+	smsPhoneRequired  = "is required to get text messages"
+
+	// outcomes
+	OutcomeAttended    = "attended"
+	OutcomeNoShow      = "no-show"
+	OutcomeRescheduled = "rescheduled"
+
+	// slot range actions
+	RangeClose  = "close"
+	RangeOpen   = "open"
+	RangeDelete = "delete"
+
+	// import kinds
+	ImportSlots    = "slots"
+	ImportBookings = "bookings"
 )
 
-const TdDateConst = "td_date"
+// SlotEnd is the end of the appointment, rows created before end_value existed use the configured slot duration
+func (td TdDate) SlotEnd() time.Time {
+	if td.EndValue.Valid {
+		return td.EndValue.Time
+	}
+	return td.DateValue.Time.Add(time.Duration(config.Sch.GetSlotDuration()) * time.Minute)
+}
+
+// DisplayTime formats the slot as a start - end window in the unit's timezone, e.g.: 09:00 AM - 09:20 AM
+func (td TdDate) DisplayTime() string {
+	tz := config.Sch.GetTimezone()
+	return td.DateValue.Time.In(tz).Format(layoutDisplayTime) + " - " + td.SlotEnd().In(tz).Format(layoutDisplayTime)
+}
+
+// LocalDay is the day (YYYY-MM-DD) of the slot in the unit's timezone
+func (td TdDate) LocalDay() string {
+	return td.DateValue.Time.In(config.Sch.GetTimezone()).Format(layoutDate)
+}
+
+// Appointment is the day and time of the slot as written in emails, e.g.: Sunday, December 7, 2025, 09:00 AM - 09:20 AM
+func (td TdDate) Appointment() string {
+	return td.DateValue.Time.In(config.Sch.GetTimezone()).Format("Monday, January 2, 2006") + ", " + td.DisplayTime()
+}
+
+// CalendarEvent is the slot as an ics event, the uid stays the same for the slot so calendar apps update rather than duplicate it
+func (td TdDate) CalendarEvent(summary, description string) ics.Event {
+	return ics.Event{
+		Uid:         fmt.Sprintf("td-date-%d@%s", td.Id, config.Srv.AppName),
+		Start:       td.DateValue.Time,
+		End:         td.SlotEnd(),
+		Summary:     summary,
+		Description: description,
+		Location:    config.Sch.Location,
+	}
+}
+
+// BookingCalendar is the calendar attached to the emails sent to the family
+func (td TdDate) BookingCalendar() []byte {
+	cal := ics.Calendar{ProdId: calendarProdId, Events: []ics.Event{td.CalendarEvent("Tithing Declaration", "")}}
+	return cal.Bytes()
+}
+
+func (c *SlotCounts) add(o SlotCounts) {
+	c.Slots += o.Slots
+	c.Held += o.Held
+	c.Confirmed += o.Confirmed
+	c.Open += o.Open
+	c.Closed += o.Closed
+}
+
+func (c *SlotCounts) setFillRate() {
+	c.FillRate = 0
+	if c.Slots > 0 {
+		c.FillRate = float64(c.Confirmed) / float64(c.Slots)
+	}
+}
 
 func InitStorageV1() DataTdDateV1Adapter {
 	return InitSQLV1()
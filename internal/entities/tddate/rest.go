@@ -2,19 +2,26 @@ package tddate
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
 	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
 	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/ics"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/xlsx"
 	"github.com/labstack/echo/v4"
+	"gopkg.in/guregu/null.v3"
 )
 
 type (
 	RestTdDateV1 struct{}
 )
 
+const importMaxBytes = 5 << 20 // 5MB, a few thousand rows
+
 var (
 	restV1   RestTdDateV1
 	domainV1 *DomainTdDateV1
@@ -34,9 +41,22 @@ func RegisterTdDate(eg *echo.Group) {
 	r.RegisterAndAdd(eg, http.MethodPatch, "/td-date", Patch)
 	r.RegisterAndAdd(eg, http.MethodDelete, "/td-date/:id", Delete)
 	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/block", CreateBlock)
+	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/expand-template", ExpandTemplate)
+	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/attendance", MarkAttendance)
+	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/range", ChangeRange)
+	r.RegisterAndAdd(eg, http.MethodGet, "/td-date/stats", Stats)
+	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/export/csv", ExportCSV)
+	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/export/xlsx", ExportXLSX)
+	r.RegisterAndAdd(eg, http.MethodGet, "/td-date/export/agenda", ExportAgenda)
+	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/import", Import)
 	r.RegisterAndAdd(eg, http.MethodGet, "/td-date/current-days", GetCurrentDays)
+	r.RegisterAndAdd(eg, http.MethodGet, "/td-date/current-days/interviewer", GetCurrentDaysByInterviewer)
 	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/check-hold-time", CheckHoldTime)
+	r.RegisterAndAdd(eg, http.MethodGet, "/td-date/hold/:token", GetHoldRemaining)
 	r.RegisterAndAdd(eg, http.MethodPost, "/td-date/confirm", Confirm)
+	r.RegisterAndAdd(eg, http.MethodGet, "/td-date/manage/:token", GetManage)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/td-date/manage/:token", CancelManage)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/td-date/manage/:token", RescheduleManage)
 }
 
 func Get(c echo.Context) error {
@@ -87,6 +107,70 @@ func CreateBlock(c echo.Context) error {
 	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
 }
 
+func ExpandTemplate(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.ExpandTemplate(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func MarkAttendance(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.MarkAttendance(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func ChangeRange(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.ChangeRange(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Stats(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Stats(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func ExportCSV(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.ExportCSV(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func ExportXLSX(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.ExportXLSX(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func ExportAgenda(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.ExportAgenda(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Import(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Import(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
 func GetCurrentDays(c echo.Context) error {
 	version := c.Get("version").(string)
 	if version == "v1" {
@@ -95,6 +179,14 @@ func GetCurrentDays(c echo.Context) error {
 	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
 }
 
+func GetCurrentDaysByInterviewer(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.GetCurrentDaysByInterviewer(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
 func CheckHoldTime(c echo.Context) error {
 	version := c.Get("version").(string)
 	if version == "v1" {
@@ -103,6 +195,19 @@ func CheckHoldTime(c echo.Context) error {
 	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
 }
 
+// RegisterTdDateFeed registers the calendar feed outside of the versioned group, calendar apps can't set the accept header
+func RegisterTdDateFeed(e *echo.Echo, m ...echo.MiddlewareFunc) {
+	e.GET("/td-date/calendar.ics", restV1.CalendarFeed, m...)
+}
+
+func GetHoldRemaining(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.GetHoldRemaining(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
 func Confirm(c echo.Context) error {
 	version := c.Get("version").(string)
 	if version == "v1" {
@@ -111,6 +216,30 @@ func Confirm(c echo.Context) error {
 	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
 }
 
+func GetManage(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.GetManage(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func CancelManage(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.CancelManage(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func RescheduleManage(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.RescheduleManage(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
 // V1
 func NewRestTdDateV1() *RestTdDateV1 {
 	return &RestTdDateV1{}
@@ -207,14 +336,167 @@ func (h *RestTdDateV1) CreateBlock(c echo.Context) error {
 	return handler.FormatResponse(c, 201, nil, nil)
 }
 
+func (h *RestTdDateV1) ExpandTemplate(c echo.Context) error {
+	ctx := context.Background()
+	expand := ExpandTemplateRequest{}
+	if err := c.Bind(&expand); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.ExpandTemplate(ctx, &expand); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, expand, nil)
+}
+
+// MarkAttendance records how the day's bookings went, e.g.: {"outcomes": [{"id": 4, "outcome": "no-show"}], "rest": "attended"}
+func (h *RestTdDateV1) MarkAttendance(c echo.Context) error {
+	ctx := context.Background()
+	attendance := AttendanceRequest{}
+	if err := c.Bind(&attendance); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.MarkAttendance(ctx, &attendance); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, attendance, nil)
+}
+
+// ChangeRange closes, opens or deletes the unbooked slots in a window, e.g.: {"action": "close", "from": "2025-10-05", "notify_booked": true}
+func (h *RestTdDateV1) ChangeRange(c echo.Context) error {
+	ctx := context.Background()
+	req := SlotRangeRequest{}
+	if err := c.Bind(&req); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.ChangeRange(ctx, &req); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, req, nil)
+}
+
+func (h *RestTdDateV1) Stats(c echo.Context) error {
+	ctx := context.Background()
+	req := StatsRequest{From: c.QueryParam("from"), To: c.QueryParam("to")}
+	if seasonIdStr := c.QueryParam("season_id"); seasonIdStr != "" {
+		seasonId, err := strconv.Atoi(seasonIdStr)
+		if err != nil {
+			bindErr := ae.BindError(err)
+			return handler.FormatResponseWithError(c, bindErr)
+		}
+		req.SeasonId = seasonId
+	}
+	stats := &TdDateStats{}
+	if err := domainV1.Stats(ctx, stats, req); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *stats, nil)
+}
+
+func (h *RestTdDateV1) ExportCSV(c echo.Context) error {
+	ctx := context.Background()
+	req := ExportRequest{}
+	if err := c.Bind(&req); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="declarations.csv"`)
+	return exportResponse(c, domainV1.ExportCSV(ctx, c.Response(), req))
+}
+
+func (h *RestTdDateV1) ExportXLSX(c echo.Context) error {
+	ctx := context.Background()
+	req := ExportRequest{}
+	if err := c.Bind(&req); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	c.Response().Header().Set(echo.HeaderContentType, xlsx.ContentType)
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="declarations.xlsx"`)
+	return exportResponse(c, domainV1.ExportXLSX(ctx, c.Response(), req))
+}
+
+func (h *RestTdDateV1) ExportAgenda(c echo.Context) error {
+	ctx := context.Background()
+	req := AgendaRequest{From: c.QueryParam("from"), To: c.QueryParam("to")}
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextHTMLCharsetUTF8)
+	return exportResponse(c, domainV1.Agenda(ctx, c.Response(), req))
+}
+
+// exportResponse sends the error as usual when nothing was streamed yet, once the export started
+// the status is already sent so the error can only be logged
+func exportResponse(c echo.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	apiError := err.(ae.ApiError)
+	if c.Response().Committed {
+		handler.LogError(c, &apiError)
+		return nil
+	}
+	c.Response().Header().Del(echo.HeaderContentDisposition)
+	return handler.FormatResponseWithError(c, apiError)
+}
+
+// the csv is the request body (text/csv) or the "file" of a multipart form, see tools/import/README.md for the columns
+func (h *RestTdDateV1) Import(c echo.Context) error {
+	ctx := context.Background()
+	req := &ImportRequest{Kind: c.QueryParam("kind")}
+	if dryRun := c.QueryParam("dry_run"); dryRun != "" {
+		d, err := strconv.ParseBool(dryRun)
+		if err != nil {
+			bindErr := ae.BindError(err)
+			return handler.FormatResponseWithError(c, bindErr)
+		}
+		req.DryRun = d
+	}
+	body := io.Reader(http.MaxBytesReader(c.Response(), c.Request().Body, importMaxBytes))
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		file, err := c.FormFile("file")
+		if err != nil {
+			bindErr := ae.BindError(err)
+			return handler.FormatResponseWithError(c, bindErr)
+		}
+		f, err := file.Open()
+		if err != nil {
+			bindErr := ae.BindError(err)
+			return handler.FormatResponseWithError(c, bindErr)
+		}
+		defer f.Close()
+		body = io.LimitReader(f, importMaxBytes)
+	}
+	if err := domainV1.Import(ctx, body, req); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *req, &req.Rows)
+}
+
 func (h *RestTdDateV1) GetCurrentDays(c echo.Context) error {
 	ctx := context.Background()
-	dayWithTimes := make(map[string][]string)
-	if err := domainV1.GetCurrentDays(ctx, dayWithTimes); err != nil {
+	current := &CurrentDateTime{}
+	if err := domainV1.GetCurrentDays(ctx, current); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, current.DayAndTimes, nil)
+}
+
+func (h *RestTdDateV1) GetCurrentDaysByInterviewer(c echo.Context) error {
+	ctx := context.Background()
+	current := &CurrentDateTime{}
+	if err := domainV1.GetCurrentDays(ctx, current); err != nil {
 		apiError := err.(ae.ApiError)
 		return handler.FormatResponseWithError(c, apiError)
 	}
-	return handler.FormatResponse(c, 200, dayWithTimes, nil)
+	return handler.FormatResponse(c, 200, current.Interviewers, nil)
 }
 
 func (h *RestTdDateV1) CheckSetHoldTime(c echo.Context) error {
@@ -224,12 +506,22 @@ func (h *RestTdDateV1) CheckSetHoldTime(c echo.Context) error {
 		bindErr := ae.BindError(err)
 		return handler.FormatResponseWithError(c, bindErr)
 	}
-	err := domainV1.CheckSetHoldTime(ctx, checkHold)
+	err := domainV1.CheckSetHoldTime(ctx, &checkHold)
 	if err != nil {
 		apiError := err.(ae.ApiError)
 		return handler.FormatResponseWithError(c, apiError)
 	}
-	return handler.FormatResponse(c, 201, nil, nil)
+	return handler.FormatResponse(c, 201, checkHold, nil)
+}
+
+func (h *RestTdDateV1) GetHoldRemaining(c echo.Context) error {
+	ctx := context.Background()
+	remaining := &HoldRemaining{HoldToken: c.Param("token")}
+	if err := domainV1.GetHoldRemaining(ctx, remaining); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *remaining, nil)
 }
 
 func (h *RestTdDateV1) Confirm(c echo.Context) error {
@@ -246,3 +538,47 @@ func (h *RestTdDateV1) Confirm(c echo.Context) error {
 	}
 	return handler.FormatResponse(c, 201, nil, nil)
 }
+
+func (h *RestTdDateV1) GetManage(c echo.Context) error {
+	ctx := context.Background()
+	tdDate := &TdDate{ManageToken: null.StringFrom(c.Param("token"))}
+	if err := domainV1.GetByManageToken(ctx, tdDate); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *tdDate, nil)
+}
+
+func (h *RestTdDateV1) CancelManage(c echo.Context) error {
+	ctx := context.Background()
+	if err := domainV1.CancelByManageToken(ctx, c.Param("token")); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestTdDateV1) RescheduleManage(c echo.Context) error {
+	ctx := context.Background()
+	moveTo := CheckHoldTimeRequest{}
+	if err := c.Bind(&moveTo); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	tdDate := &TdDate{}
+	if err := domainV1.RescheduleByManageToken(ctx, c.Param("token"), moveTo, tdDate); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *tdDate, nil)
+}
+
+func (h *RestTdDateV1) CalendarFeed(c echo.Context) error {
+	ctx := context.Background()
+	calendar, err := domainV1.CalendarFeed(ctx)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.Blob(http.StatusOK, ics.ContentType, calendar)
+}
@@ -2,6 +2,7 @@ package tddate
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
@@ -17,6 +18,47 @@ type (
 	}
 )
 
+const sqlInsert = `
+		INSERT INTO td_date (
+			id,
+			interviewer_id,
+			date_value,
+			end_value,
+			hold,
+			confirm,
+			name,
+			phone,
+			email,
+			sms_opt_in,
+			household_id,
+			season_id,
+			outcome,
+			outcome_at,
+			closed_at,
+			manage_token,
+			hold_token,
+			expires_at
+		) VALUES (
+		 	:id,
+			:interviewer_id,
+			:date_value,
+			:end_value,
+			:hold,
+			:confirm,
+			:name,
+			:phone,
+			:email,
+			:sms_opt_in,
+			:household_id,
+			:season_id,
+			:outcome,
+			:outcome_at,
+			:closed_at,
+			:manage_token,
+			:hold_token,
+			:expires_at
+		)`
+
 func InitSQLV1() *SQLTdDateV1 {
 	db := stor.InitStorage()
 	return &SQLTdDateV1{DB: db}
@@ -26,12 +68,23 @@ func (d *SQLTdDateV1) Read(ctx context.Context, td_ *TdDate) error {
 	sqlGet := `
 		SELECT
 			id,
+			interviewer_id,
 			date_value,
+			end_value,
 			hold,
 			confirm,
 			name,
 			phone,
-			email
+			email,
+			sms_opt_in,
+			household_id,
+			season_id,
+			outcome,
+			outcome_at,
+			closed_at,
+			manage_token,
+			hold_token,
+			expires_at
 		FROM td_date WHERE id = $1`
 	if errDB := d.DB.Get(td_, sqlGet, td_.Id, td_.DateValue, td_.Hold, td_.Confirm, td_.Name, td_.Phone, td_.Email); errDB != nil {
 		return ae.DBError("TdDate Get: unable to get record.", errDB)
@@ -44,12 +97,23 @@ func (d *SQLTdDateV1) ReadAll(ctx context.Context, td_ *[]TdDate, param TdDatePa
 	sqlSearch := fmt.Sprintf(`
 		SELECT
 			id,
+			interviewer_id,
 			date_value,
+			end_value,
 			hold,
 			confirm,
 			name,
 			phone,
-			email
+			email,
+			sms_opt_in,
+			household_id,
+			season_id,
+			outcome,
+			outcome_at,
+			closed_at,
+			manage_token,
+			hold_token,
+			expires_at
 		FROM td_date
 		%s
 		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
@@ -76,25 +140,7 @@ func (d *SQLTdDateV1) Create(ctx context.Context, td_ *TdDate) error {
 		return err
 	}
 	td_.Id = count
-	sqlPost := `
-		INSERT INTO td_date (
-			id,
-			date_value,
-			hold,
-			confirm,
-			name,
-			phone,
-			email
-		) VALUES (
-		 	:id,
-			:date_value,
-			:hold,
-			:confirm,
-			:name,
-			:phone,
-			:email
-		)`
-	_, errDB := d.DB.NamedExec(sqlPost, td_)
+	_, errDB := d.DB.NamedExec(sqlInsert, td_)
 	if errDB != nil {
 		return ae.DBError("TdDate Post: unable to insert record.", errDB)
 	}
@@ -105,12 +151,23 @@ func (d *SQLTdDateV1) Create(ctx context.Context, td_ *TdDate) error {
 func (d *SQLTdDateV1) Update(ctx context.Context, td_ TdDate) error {
 	sqlPatch := `
 		UPDATE td_date SET
+			interviewer_id = :interviewer_id,
 			date_value = :date_value,
+			end_value = :end_value,
 			hold = :hold,
 			confirm = :confirm,
 			name = :name,
 			phone = :phone,
-			email = :email
+			email = :email,
+			sms_opt_in = :sms_opt_in,
+			household_id = :household_id,
+			season_id = :season_id,
+			outcome = :outcome,
+			outcome_at = :outcome_at,
+			closed_at = :closed_at,
+			manage_token = :manage_token,
+			hold_token = :hold_token,
+			expires_at = :expires_at
 		WHERE id = :id`
 	if _, errDB := d.DB.NamedExec(sqlPatch, td_); errDB != nil {
 		return ae.DBError("TdDate Patch: unable to update record.", errDB)
@@ -127,11 +184,13 @@ func (d *SQLTdDateV1) Delete(ctx context.Context, td_ *TdDate) error {
 	return nil
 }
 
-func (d *SQLTdDateV1) GetCurrentDays(ctx context.Context, dates *[]time.Time, param TdDateParam) error {
+func (d *SQLTdDateV1) GetCurrentDays(ctx context.Context, dates *[]TdDate, param TdDateParam) error {
 	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
 	sqlSearch := fmt.Sprintf(`
 		SELECT
-			date_value
+			interviewer_id,
+			date_value,
+			end_value
 		FROM td_date
 		%s
 		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
@@ -142,44 +201,447 @@ func (d *SQLTdDateV1) GetCurrentDays(ctx context.Context, dates *[]time.Time, pa
 	return nil
 }
 
-// checks if the given dateTime is available to be held (i.e. not already held)
-// if available, will hold the time
-func (d *SQLTdDateV1) CheckSetHoldTime(ctx context.Context, dateTime time.Time) error {
-	exists := true
-	sqlExists := `
-		SELECT EXISTS (
-			SELECT 1 FROM td_date WHERE date_value = $1 AND hold IS NOT NULL
-		)`
-	if errDB := d.DB.Get(&exists, sqlExists, dateTime); errDB != nil {
-		return ae.DBError("TdDate CheckHoldTime: unable to check hold time.", errDB)
-	}
-	if exists {
-		return ae.HoldError()
-	}
+// holds the first free slot at date_value for the interviewer (0 => any active or unassigned interviewer)
+// a slot is free if it isn't closed or confirmed and is not held or its hold expired (the sweeper may not have released it yet)
+// the hold is a single conditional update, only one caller can win; td_.hold_token is stamped on the held slot
+// and the id and interviewer_id of that slot are set on td_
+func (d *SQLTdDateV1) CheckSetHoldTime(ctx context.Context, td_ *TdDate) (err error) {
+	txn := d.DB.MustBegin()
+	defer usql.TxnFinish(txn, &err)
+
 	sqlHold := `
 		UPDATE td_date SET
-			hold = $1
-		WHERE date_value = $2 AND hold IS NULL`
-	if _, errDB := d.DB.Exec(sqlHold, time.Now().UTC(), dateTime); errDB != nil {
-		return ae.DBError("TdDate CheckHoldTime: unable to hold time.", errDB)
+			hold = $1,
+			hold_token = $2,
+			expires_at = $3
+		WHERE id = (
+			SELECT id FROM td_date
+			WHERE date_value = $4 AND ($5 = 0 OR interviewer_id = $5) AND closed_at IS NULL
+				AND confirm IS NULL AND (hold IS NULL OR expires_at <= $1)
+				AND interviewer_id NOT IN (SELECT id FROM interviewer WHERE active = 0)
+			ORDER BY interviewer_id
+			LIMIT 1
+		) AND confirm IS NULL AND (hold IS NULL OR expires_at <= $1)`
+	result, errDB := txn.Exec(sqlHold, td_.Hold, td_.HoldToken, td_.ExpiresAt, td_.DateValue, td_.InterviewerId)
+	if errDB != nil {
+		err = ae.DBError("TdDate CheckHoldTime: unable to hold time.", errDB)
+		return
 	}
-	return nil
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		err = ae.HoldError()
+		return
+	}
+	sqlHeld := `
+		SELECT
+			id,
+			interviewer_id
+		FROM td_date WHERE hold_token = $1`
+	if errDB := txn.Get(td_, sqlHeld, td_.HoldToken); errDB != nil {
+		err = ae.DBError("TdDate CheckHoldTime: unable to get held time.", errDB)
+	}
+	return
 }
 
-func (d *SQLTdDateV1) Confirm(ctx context.Context, dtDate TdDate) error {
+// confirms the slot held with hold_token, the hold token is used up
+func (d *SQLTdDateV1) Confirm(ctx context.Context, dtDate *TdDate) error {
 	sqlConfirm := `
 		UPDATE td_date SET
 			confirm = :confirm,
 			name = :name,
 			email = :email,
-			phone = :phone
-		WHERE date_value = :date_value`
-	if _, errDB := d.DB.NamedExec(sqlConfirm, dtDate); errDB != nil {
+			phone = :phone,
+			sms_opt_in = :sms_opt_in,
+			manage_token = :manage_token,
+			hold_token = NULL,
+			expires_at = NULL
+		WHERE hold_token = :hold_token AND hold IS NOT NULL AND confirm IS NULL AND (expires_at IS NULL OR expires_at > :confirm)`
+	result, errDB := d.DB.NamedExec(sqlConfirm, dtDate)
+	if errDB != nil {
 		return ae.DBError("TdDate Confirm: unable to confirm time.", errDB)
 	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ae.HoldTokenInvalidError()
+	}
 	return nil
 }
 
+func (d *SQLTdDateV1) ReadByManageToken(ctx context.Context, td_ *TdDate) error {
+	sqlGet := `
+		SELECT
+			id,
+			interviewer_id,
+			date_value,
+			end_value,
+			hold,
+			confirm,
+			name,
+			phone,
+			email,
+			sms_opt_in,
+			household_id,
+			season_id,
+			outcome,
+			outcome_at,
+			closed_at,
+			manage_token,
+			hold_token,
+			expires_at
+		FROM td_date WHERE manage_token = $1`
+	if errDB := d.DB.Get(td_, sqlGet, td_.ManageToken); errDB != nil {
+		if errDB == sql.ErrNoRows {
+			return ae.ManageTokenInvalidError()
+		}
+		return ae.DBError("TdDate ReadByManageToken: unable to get record.", errDB)
+	}
+	return nil
+}
+
+// moves the booking in from onto the first free slot at to.date_value (for to.interviewer_id, 0 => any)
+// the old slot is released and the new slot is filled in the same transaction, to is filled with the new slot
+// to.hold is kept as given; from must still be the family's confirmed booking, one cancelled or released since it was read fails
+func (d *SQLTdDateV1) Reschedule(ctx context.Context, from TdDate, to *TdDate) (err error) {
+	txn := d.DB.MustBegin()
+	defer usql.TxnFinish(txn, &err)
+
+	sqlFree := `
+		SELECT
+			id,
+			interviewer_id,
+			date_value,
+			end_value,
+			season_id
+		FROM td_date
+		WHERE date_value = $1 AND hold IS NULL AND confirm IS NULL AND closed_at IS NULL AND ($2 = 0 OR interviewer_id = $2)
+			AND interviewer_id NOT IN (SELECT id FROM interviewer WHERE active = 0)
+		ORDER BY interviewer_id
+		LIMIT 1`
+	if errDB := txn.Get(to, sqlFree, to.DateValue, to.InterviewerId); errDB != nil {
+		if errDB == sql.ErrNoRows {
+			err = ae.HoldError()
+			return
+		}
+		err = ae.DBError("TdDate Reschedule: unable to find a free time.", errDB)
+		return
+	}
+	sqlRelease := `
+		UPDATE td_date SET
+			hold = NULL,
+			confirm = NULL,
+			name = NULL,
+			phone = NULL,
+			email = NULL,
+			sms_opt_in = NULL,
+			household_id = NULL,
+			outcome = NULL,
+			outcome_at = NULL,
+			manage_token = NULL,
+			hold_token = NULL,
+			expires_at = NULL
+		WHERE id = $1 AND manage_token = $2 AND confirm IS NOT NULL`
+	result, errDB := txn.Exec(sqlRelease, from.Id, from.ManageToken)
+	if errDB != nil {
+		err = ae.DBError("TdDate Reschedule: unable to release the current time.", errDB)
+		return
+	}
+	// cancelled or released since from was read
+	if rows, _ := result.RowsAffected(); rows != 1 {
+		err = ae.ManageTokenInvalidError()
+		return
+	}
+	to.Confirm = from.Confirm
+	to.Name = from.Name
+	to.Phone = from.Phone
+	to.Email = from.Email
+	to.SmsOptIn = from.SmsOptIn
+	to.HouseholdId = from.HouseholdId
+	to.ManageToken = from.ManageToken
+	sqlBook := `
+		UPDATE td_date SET
+			hold = :hold,
+			confirm = :confirm,
+			name = :name,
+			phone = :phone,
+			email = :email,
+			sms_opt_in = :sms_opt_in,
+			household_id = :household_id,
+			manage_token = :manage_token
+		WHERE id = :id AND hold IS NULL AND confirm IS NULL AND closed_at IS NULL`
+	result, errDB = txn.NamedExec(sqlBook, to)
+	if errDB != nil {
+		err = ae.DBError("TdDate Reschedule: unable to book the new time.", errDB)
+		return
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		err = ae.HoldError()
+	}
+	return
+}
+
+func (d *SQLTdDateV1) ReadByHoldToken(ctx context.Context, td_ *TdDate) error {
+	sqlGet := `
+		SELECT
+			id,
+			interviewer_id,
+			date_value,
+			end_value,
+			hold,
+			expires_at
+		FROM td_date WHERE hold_token = $1 AND confirm IS NULL`
+	if errDB := d.DB.Get(td_, sqlGet, td_.HoldToken); errDB != nil {
+		if errDB == sql.ErrNoRows {
+			return ae.HoldTokenInvalidError()
+		}
+		return ae.DBError("TdDate ReadByHoldToken: unable to get record.", errDB)
+	}
+	return nil
+}
+
+// releases unconfirmed holds that expired by now, holds without an expires_at are released if held before heldBefore
+// released is filled with the slots that were freed up
+func (d *SQLTdDateV1) ReleaseExpiredHolds(ctx context.Context, now, heldBefore time.Time, released *[]TdDate) (err error) {
+	txn := d.DB.MustBegin()
+	defer usql.TxnFinish(txn, &err)
+
+	sqlExpired := `
+		SELECT
+			id,
+			interviewer_id,
+			date_value,
+			end_value
+		FROM td_date
+		WHERE hold IS NOT NULL AND confirm IS NULL
+			AND (expires_at <= $1 OR (expires_at IS NULL AND hold <= $2))`
+	if errDB := txn.Select(released, sqlExpired, now, heldBefore); errDB != nil {
+		err = ae.DBError("TdDate ReleaseExpiredHolds: unable to select expired holds.", errDB)
+		return
+	}
+	sqlRelease := `
+		UPDATE td_date SET
+			hold = NULL,
+			hold_token = NULL,
+			expires_at = NULL
+		WHERE hold IS NOT NULL AND confirm IS NULL
+			AND (expires_at <= $1 OR (expires_at IS NULL AND hold <= $2))`
+	if _, errDB := txn.Exec(sqlRelease, now, heldBefore); errDB != nil {
+		err = ae.DBError("TdDate ReleaseExpiredHolds: unable to release holds.", errDB)
+	}
+	return
+}
+
+// sets expires_at of the unconfirmed hold that expires first, left null when nothing is held
+func (d *SQLTdDateV1) NextHoldExpiry(ctx context.Context, td_ *TdDate) error {
+	sqlNext := `
+		SELECT
+			expires_at
+		FROM td_date
+		WHERE hold IS NOT NULL AND confirm IS NULL AND expires_at IS NOT NULL
+		ORDER BY expires_at
+		LIMIT 1`
+	if errDB := d.DB.Get(td_, sqlNext); errDB != nil {
+		if errDB == sql.ErrNoRows {
+			return nil
+		}
+		return ae.DBError("TdDate NextHoldExpiry: unable to get next expiry.", errDB)
+	}
+	return nil
+}
+
+// sets household_id on the confirmed slot
+func (d *SQLTdDateV1) SetHousehold(ctx context.Context, td_ TdDate) error {
+	sqlHousehold := `
+		UPDATE td_date SET
+			household_id = :household_id
+		WHERE id = :id AND confirm IS NOT NULL`
+	if _, errDB := d.DB.NamedExec(sqlHousehold, td_); errDB != nil {
+		return ae.DBError("TdDate SetHousehold: unable to update record.", errDB)
+	}
+	return nil
+}
+
+// sets the outcome (attended, no-show or rescheduled) on the confirmed slot
+func (d *SQLTdDateV1) SetOutcome(ctx context.Context, td_ TdDate) error {
+	sqlOutcome := `
+		UPDATE td_date SET
+			outcome = :outcome,
+			outcome_at = :outcome_at
+		WHERE id = :id AND confirm IS NOT NULL`
+	if _, errDB := d.DB.NamedExec(sqlOutcome, td_); errDB != nil {
+		return ae.DBError("TdDate SetOutcome: unable to update record.", errDB)
+	}
+	return nil
+}
+
+// sums up the slots picked by param (columns aliased with t.) by start time and season, along with the booking lead time
+func (d *SQLTdDateV1) Stats(ctx context.Context, stats *StatsAggregate, param TdDateParam) error {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	counts := `
+			COUNT(*) AS slots,
+			COUNT(CASE WHEN t.hold IS NOT NULL AND t.confirm IS NULL THEN 1 END) AS held,
+			COUNT(t.confirm) AS confirmed,
+			COUNT(CASE WHEN t.hold IS NULL AND t.confirm IS NULL AND t.closed_at IS NULL THEN 1 END) AS open,
+			COUNT(t.closed_at) AS closed`
+	sqlStarts := fmt.Sprintf(`
+		SELECT
+			strftime('%%Y-%%m-%%d %%H:%%M', t.date_value) AS start,%s
+		FROM td_date t
+		%s
+		GROUP BY start
+		ORDER BY start`, counts, searchStmt)
+	sqlStarts = d.DB.Rebind(sqlStarts)
+	if errDB := d.DB.Select(&stats.Starts, sqlStarts, args...); errDB != nil {
+		return ae.DBError("TdDate Stats: unable to select start times.", errDB)
+	}
+	sqlSeasons := fmt.Sprintf(`
+		SELECT
+			t.season_id,
+			s.name,%s
+		FROM td_date t
+		LEFT JOIN season s ON s.id = t.season_id
+		%s
+		GROUP BY t.season_id, s.name
+		ORDER BY s.open_date DESC`, counts, searchStmt)
+	sqlSeasons = d.DB.Rebind(sqlSeasons)
+	if errDB := d.DB.Select(&stats.Seasons, sqlSeasons, args...); errDB != nil {
+		return ae.DBError("TdDate Stats: unable to select seasons.", errDB)
+	}
+	sqlLeadTime := fmt.Sprintf(`
+		SELECT
+			COUNT(t.confirm) AS bookings,
+			COALESCE(AVG(julianday(t.date_value) - julianday(t.confirm)), 0) AS average_days,
+			COALESCE(MIN(julianday(t.date_value) - julianday(t.confirm)), 0) AS min_days,
+			COALESCE(MAX(julianday(t.date_value) - julianday(t.confirm)), 0) AS max_days
+		FROM td_date t
+		%s`, searchStmt)
+	sqlLeadTime = d.DB.Rebind(sqlLeadTime)
+	if errDB := d.DB.Get(&stats.LeadTime, sqlLeadTime, args...); errDB != nil {
+		return ae.DBError("TdDate Stats: unable to select lead time.", errDB)
+	}
+	return nil
+}
+
+// inserts every slot in one transaction, either all of them are saved or none; the ids are set on the new slots
+// a booking with an id goes into that slot, only while it is still free (HoldError otherwise)
+func (d *SQLTdDateV1) Import(ctx context.Context, tdDates []*TdDate) (err error) {
+	txn := d.DB.MustBegin()
+	defer usql.TxnFinish(txn, &err)
+
+	id := 0
+	if errDB := txn.Get(&id, "SELECT COALESCE(MAX(id), 0) FROM td_date"); errDB != nil {
+		err = ae.DBError("TdDate Import: unable to select count.", errDB)
+		return
+	}
+	sqlBook := `
+		UPDATE td_date SET
+			hold = :hold,
+			confirm = :confirm,
+			name = :name,
+			phone = :phone,
+			email = :email,
+			sms_opt_in = :sms_opt_in,
+			manage_token = :manage_token
+		WHERE id = :id AND hold IS NULL AND confirm IS NULL`
+	for _, td_ := range tdDates {
+		if td_.Id > 0 {
+			result, errDB := txn.NamedExec(sqlBook, td_)
+			if errDB != nil {
+				err = ae.DBError(fmt.Sprintf("TdDate Import: unable to book record at %s.", td_.DateValue.Time.Format(time.RFC3339)), errDB)
+				return
+			}
+			// booked since the import was checked
+			if rows, _ := result.RowsAffected(); rows == 0 {
+				err = ae.HoldError()
+				return
+			}
+			continue
+		}
+		id++
+		td_.Id = id
+		if _, errDB := txn.NamedExec(sqlInsert, td_); errDB != nil {
+			err = ae.DBError(fmt.Sprintf("TdDate Import: unable to insert record at %s.", td_.DateValue.Time.Format(time.RFC3339)), errDB)
+			return
+		}
+	}
+	return
+}
+
+// closes (closed_at set and any unconfirmed hold dropped), opens or deletes the unbooked slots in sr, all in one transaction
+// changed is filled with the slots as they were before the change and booked with the confirmed slots in the window, which are left alone
+func (d *SQLTdDateV1) ChangeRange(ctx context.Context, sr SlotRange, changed, booked *[]TdDate) (err error) {
+	txn := d.DB.MustBegin()
+	defer usql.TxnFinish(txn, &err)
+
+	window := "date_value >= ? AND date_value < ? AND (? = 0 OR interviewer_id = ?)"
+	args := []any{sr.From, sr.To, sr.InterviewerId, sr.InterviewerId}
+	sqlSelect := `
+		SELECT
+			id,
+			interviewer_id,
+			date_value,
+			end_value,
+			hold,
+			confirm,
+			name,
+			phone,
+			email,
+			sms_opt_in,
+			household_id,
+			season_id,
+			outcome,
+			outcome_at,
+			closed_at,
+			manage_token,
+			hold_token,
+			expires_at
+		FROM td_date
+		WHERE %s AND %s
+		ORDER BY date_value, interviewer_id`
+	if errDB := txn.Select(booked, txn.Rebind(fmt.Sprintf(sqlSelect, window, "confirm IS NOT NULL")), args...); errDB != nil {
+		err = ae.DBError("TdDate ChangeRange: unable to select bookings.", errDB)
+		return
+	}
+	pick := "confirm IS NULL"
+	sqlChange := "DELETE FROM td_date WHERE %s AND %s"
+	changeArgs := args
+	switch sr.Action {
+	case RangeClose:
+		pick = "confirm IS NULL AND closed_at IS NULL"
+		sqlChange = `
+		UPDATE td_date SET
+			closed_at = ?,
+			hold = NULL,
+			hold_token = NULL,
+			expires_at = NULL
+		WHERE %s AND %s`
+		changeArgs = append([]any{sr.ClosedAt}, args...)
+	case RangeOpen:
+		pick = "closed_at IS NOT NULL"
+		sqlChange = `
+		UPDATE td_date SET
+			closed_at = NULL
+		WHERE %s AND %s`
+	}
+	if errDB := txn.Select(changed, txn.Rebind(fmt.Sprintf(sqlSelect, window, pick)), args...); errDB != nil {
+		err = ae.DBError("TdDate ChangeRange: unable to select slots.", errDB)
+		return
+	}
+	if _, errDB := txn.Exec(txn.Rebind(fmt.Sprintf(sqlChange, window, pick)), changeArgs...); errDB != nil {
+		err = ae.DBError(fmt.Sprintf("TdDate ChangeRange: unable to %s slots.", sr.Action), errDB)
+	}
+	return
+}
+
+func (d *SQLTdDateV1) Exists(ctx context.Context, interviewerId int, dateTime time.Time) (bool, error) {
+	exists := false
+	sqlExists := `
+		SELECT EXISTS (
+			SELECT 1 FROM td_date WHERE interviewer_id = $1 AND date_value = $2
+		)`
+	if errDB := d.DB.Get(&exists, sqlExists, interviewerId, dateTime); errDB != nil {
+		return false, ae.DBError("TdDate Exists: unable to check date value.", errDB)
+	}
+	return exists, nil
+}
+
 func (d *SQLTdDateV1) count() (int, error) {
 	count := 0
 	sqlCount := `
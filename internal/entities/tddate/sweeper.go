@@ -0,0 +1,81 @@
+package tddate
+
+import (
+	"context"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+)
+
+type (
+	// HoldSweeper releases expired holds, it sleeps until the next hold expires instead of polling
+	// CheckSetHoldTime wakes it up so a new hold is picked up even when nothing was held
+	HoldSweeper struct {
+		domain *DomainTdDateV1
+		wake   chan struct{}
+		stop   chan struct{}
+		done   chan struct{}
+	}
+)
+
+var holdSweeperV1 *HoldSweeper
+
+// InitializeHoldSweeperV1 hooks the sweeper up to the domain built by InitializeTdDateV1
+func InitializeHoldSweeperV1() *HoldSweeper {
+	holdSweeperV1 = NewHoldSweeper(domainV1)
+	domainV1.holdSweeper = holdSweeperV1
+	return holdSweeperV1
+}
+
+func NewHoldSweeper(domain *DomainTdDateV1) *HoldSweeper {
+	return &HoldSweeper{domain: domain, wake: make(chan struct{}, 1)}
+}
+
+func (s *HoldSweeper) Start() {
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.run()
+}
+
+// Stop waits for a sweep in progress to finish
+func (s *HoldSweeper) Stop() {
+	if s.stop == nil {
+		return
+	}
+	close(s.stop)
+	<-s.done
+	s.stop = nil
+}
+
+// Wake never blocks, a wake up already pending covers this one
+func (s *HoldSweeper) Wake() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *HoldSweeper) run() {
+	defer close(s.done)
+	ctx := context.Background()
+	timer := time.NewTimer(0) // sweep right away for anything that expired while stopped
+	defer timer.Stop()
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-s.wake:
+		case <-timer.C:
+		}
+		next, err := s.domain.ReleaseExpiredHolds(ctx)
+		if err != nil {
+			logging.Default.Println("Error releasing expired holds:", err)
+		}
+		// never sleep longer than the ttl, holds placed before expires_at existed have no expiry to wait on
+		wait := holdTTL()
+		if !next.IsZero() && time.Until(next) < wait {
+			wait = max(time.Until(next), 0)
+		}
+		timer.Reset(wait)
+	}
+}
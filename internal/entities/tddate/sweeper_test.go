@@ -0,0 +1,42 @@
+package tddate
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHoldSweeper(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDataTdDate := NewMockDataTdDateV1Adapter(ctrl)
+	sweeps := make(chan struct{}, 10)
+	mockDataTdDate.EXPECT().ReleaseExpiredHolds(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _, _ time.Time, _ *[]TdDate) error {
+		sweeps <- struct{}{}
+		return nil
+	}).AnyTimes()
+	// nothing held, the sweeper should sleep until woken up
+	mockDataTdDate.EXPECT().NextHoldExpiry(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	s := NewHoldSweeper(&DomainTdDateV1{dataTdDateV1: mockDataTdDate})
+	s.Start()
+
+	waitForSweep := func(msg string) {
+		select {
+		case <-sweeps:
+		case <-time.After(time.Second):
+			assert.Fail(t, msg)
+		}
+	}
+	waitForSweep("HoldSweeper.Start() => expected a sweep on start")
+	s.Wake()
+	waitForSweep("HoldSweeper.Wake() => expected a sweep after wake")
+	s.Stop()
+	s.Wake()
+	select {
+	case <-sweeps:
+		assert.Fail(t, "HoldSweeper.Stop() => expected no sweep after stop")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
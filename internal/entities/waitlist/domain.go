@@ -0,0 +1,231 @@
+package waitlist
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	a "github.com/blackflagsoftware/tithe-declare/internal/audit"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/function"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/validate"
+	"gopkg.in/guregu/null.v3"
+)
+
+//go:generate mockgen -source=domain.go -destination=mock.go -package=waitlist
+type (
+	DataWaitlistV1Adapter interface {
+		Read(context.Context, *Waitlist) error
+		ReadAll(context.Context, *[]Waitlist, WaitlistParam) (int, error)
+		Create(context.Context, *Waitlist) error
+		Update(context.Context, Waitlist) error
+		Delete(context.Context, *Waitlist) error
+		ReadByOfferToken(context.Context, *Waitlist) error
+		NextForDay(context.Context, *Waitlist, string, int, time.Time) error
+	}
+
+	// SlotHolder is the part of the td_date domain the waitlist uses to offer and book a slot
+	SlotHolder interface {
+		HoldSlot(context.Context, *tddate.TdDate, time.Duration) error
+		Confirm(context.Context, tddate.ConfirmRequest) error
+	}
+
+	DomainWaitlistV1 struct {
+		dataWaitlistV1 DataWaitlistV1Adapter
+		slotHolder     SlotHolder
+		auditWriter    a.AuditAdapter
+		emailer        email.Emailer
+		offerLock      sync.Mutex // one offer at a time so the same person isn't offered two slots at once
+	}
+)
+
+func NewDomainWaitlistV1(cwlV1 DataWaitlistV1Adapter, slotHolder SlotHolder) *DomainWaitlistV1 {
+	aw := a.AuditInit()
+	em := email.EmailInit()
+	return &DomainWaitlistV1{dataWaitlistV1: cwlV1, slotHolder: slotHolder, auditWriter: aw, emailer: em}
+}
+
+func (m *DomainWaitlistV1) Get(ctx context.Context, wl *Waitlist) error {
+	if wl.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	return m.dataWaitlistV1.Read(ctx, wl)
+}
+
+func (m *DomainWaitlistV1) Search(ctx context.Context, wl *[]Waitlist, param WaitlistParam) (int, error) {
+	// the second argument (map[string]string) is a list of columns to use for filtering
+	// the key matches the json struct tag, the value is the actual table column name (this should change if aliases are used in your query)
+	param.Param.CalculateParam("created_at", map[string]string{"id": "id", "name": "name", "email": "email", "phone": "phone", "created_at": "created_at", "offer_td_date_id": "offer_td_date_id", "offer_expires_at": "offer_expires_at", "claimed_at": "claimed_at"})
+	param.Param.PaginationString = stor.FormatPagination(param.Param.Limit, param.Param.Offset)
+
+	return m.dataWaitlistV1.ReadAll(ctx, wl, param)
+}
+
+func (m *DomainWaitlistV1) Post(ctx context.Context, wl *Waitlist) error {
+	if !wl.Name.Valid {
+		return ae.MissingParamError("Name")
+	}
+	if !wl.Email.Valid {
+		return ae.MissingParamError("Email")
+	}
+	if err := wl.validate(); err != nil {
+		return err
+	}
+	wl.CreatedAt = null.TimeFrom(time.Now().UTC())
+	wl.OfferTdDateId = null.Int{}
+	wl.OfferToken = null.String{}
+	wl.OfferExpiresAt = null.Time{}
+	wl.ClaimedAt = null.Time{}
+	if err := m.dataWaitlistV1.Create(ctx, wl); err != nil {
+		return err
+	}
+	go a.AuditCreate(m.auditWriter, *wl, WaitlistConst, a.KeysToString("id", wl.Id))
+	return nil
+}
+
+func (m *DomainWaitlistV1) Patch(ctx context.Context, wlIn Waitlist) error {
+	wl := &Waitlist{Id: wlIn.Id}
+	errGet := m.dataWaitlistV1.Read(ctx, wl)
+	if errGet != nil {
+		return errGet
+	}
+	existingValues := make(map[string]any)
+	// Name
+	if wlIn.Name.Valid {
+		existingValues["name"] = wl.Name.String
+		wl.Name = wlIn.Name
+	}
+	// Email
+	if wlIn.Email.Valid {
+		existingValues["email"] = wl.Email.String
+		wl.Email = wlIn.Email
+	}
+	// Phone
+	if wlIn.Phone.Valid {
+		existingValues["phone"] = wl.Phone.String
+		wl.Phone = wlIn.Phone
+	}
+	// PreferredDays
+	if wlIn.PreferredDays != nil {
+		existingValues["preferred_days"] = wl.PreferredDays
+		wl.PreferredDays = wlIn.PreferredDays
+	}
+	if err := wl.validate(); err != nil {
+		return err
+	}
+	if err := m.dataWaitlistV1.Update(ctx, *wl); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *wl, WaitlistConst, a.KeysToString("id", wl.Id), existingValues)
+	return nil
+}
+
+func (m *DomainWaitlistV1) Delete(ctx context.Context, wl *Waitlist) error {
+	if wl.Id < 1 {
+		return ae.MissingParamError("Id")
+	}
+	if err := m.dataWaitlistV1.Delete(ctx, wl); err != nil {
+		return err
+	}
+	go a.AuditDelete(m.auditWriter, *wl, WaitlistConst, a.KeysToString("id", wl.Id))
+	return nil
+}
+
+// SlotReleased offers the freed slot to the first person waiting for that day
+// the slot is held for them for the claim ttl, if they don't claim it the hold expires and it is offered to the next person
+func (m *DomainWaitlistV1) SlotReleased(ctx context.Context, td_ tddate.TdDate) {
+	now := time.Now().UTC()
+	if !td_.DateValue.Time.After(now) {
+		return
+	}
+	m.offerLock.Lock()
+	defer m.offerLock.Unlock()
+
+	wl := &Waitlist{}
+	if err := m.dataWaitlistV1.NextForDay(ctx, wl, td_.LocalDay(), td_.Id, now); err != nil {
+		logging.Default.Println("Error finding waitlist for td_date id", td_.Id, ":", err)
+		return
+	}
+	if wl.Id == 0 {
+		// no one is waiting
+		return
+	}
+	held := &tddate.TdDate{InterviewerId: td_.InterviewerId, DateValue: td_.DateValue}
+	if err := m.slotHolder.HoldSlot(ctx, held, time.Duration(config.Sch.GetWaitlistClaimTTL())*time.Minute); err != nil {
+		// most likely someone else grabbed the time first
+		return
+	}
+	existingValues := map[string]any{"offer_td_date_id": wl.OfferTdDateId.Int64, "offer_expires_at": wl.OfferExpiresAt.Time.Format(time.RFC3339)}
+	wl.OfferTdDateId = null.IntFrom(int64(held.Id))
+	wl.OfferToken = held.HoldToken
+	wl.OfferExpiresAt = held.ExpiresAt
+	if err := m.dataWaitlistV1.Update(ctx, *wl); err != nil {
+		logging.Default.Println("Error saving waitlist offer for waitlist id", wl.Id, ":", err)
+		return
+	}
+	go a.AuditPatch(m.auditWriter, *wl, WaitlistConst, a.KeysToString("id", wl.Id), existingValues)
+	appointment := td_.Appointment()
+	go m.emailer.SendWaitlistOffer(ctx, wl.Email.String, appointment, wl.OfferToken.String, wl.OfferExpiresAt.Time)
+}
+
+// Claim books the slot offered to the waitlisted person, the claim token is the hold token of the offer
+func (m *DomainWaitlistV1) Claim(ctx context.Context, wl *Waitlist) error {
+	if !wl.OfferToken.Valid || wl.OfferToken.String == "" {
+		return ae.MissingParamError("OfferToken")
+	}
+	if err := m.dataWaitlistV1.ReadByOfferToken(ctx, wl); err != nil {
+		return err
+	}
+	if wl.ClaimedAt.Valid || !wl.OfferExpiresAt.Time.After(time.Now().UTC()) {
+		return ae.HoldTokenInvalidError()
+	}
+	confirm := tddate.ConfirmRequest{
+		TdDate:    tddate.TdDate{Name: wl.Name, Email: wl.Email, Phone: wl.Phone},
+		HoldToken: wl.OfferToken.String,
+	}
+	if err := m.slotHolder.Confirm(ctx, confirm); err != nil {
+		return err
+	}
+	wl.ClaimedAt = null.TimeFrom(time.Now().UTC())
+	if err := m.dataWaitlistV1.Update(ctx, *wl); err != nil {
+		return err
+	}
+	go a.AuditPatch(m.auditWriter, *wl, WaitlistConst, a.KeysToString("id", wl.Id), map[string]any{"claimed_at": ""})
+	return nil
+}
+
+// validate trims and normalizes the contact the same way tddate.Confirm does, so an offered slot can always be claimed
+func (wl *Waitlist) validate() error {
+	v := &validate.Validator{}
+	v.Required("name", &wl.Name)
+	v.MaxLength("name", wl.Name, 255)
+	v.Required("email", &wl.Email)
+	v.Email("email", &wl.Email)
+	v.MaxLength("email", wl.Email, 100)
+	v.Phone("phone", &wl.Phone, config.S.DefaultCountry)
+	v.MaxLength("phone", wl.Phone, 255)
+	if err := v.Err(); err != nil {
+		return err
+	}
+	if wl.PreferredDays != nil && string(*wl.PreferredDays) != "null" {
+		if !function.ValidJson(*wl.PreferredDays) {
+			return ae.ParseError("Invalid JSON syntax for PreferredDays")
+		}
+		days := []string{}
+		if err := json.Unmarshal(*wl.PreferredDays, &days); err != nil {
+			return ae.ParseError("Invalid JSON syntax for PreferredDays")
+		}
+		for _, day := range days {
+			if _, err := time.Parse(layoutDate, day); err != nil {
+				return ae.ParseError("PreferredDays not in correct format")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package waitlist
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/email"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func rawJson(s string) *json.RawMessage {
+	r := json.RawMessage(s)
+	return &r
+}
+
+func TestDomainWaitlistV1_Post(t *testing.T) {
+	ctx := context.TODO()
+	defer func(country string) { config.S.DefaultCountry = country }(config.S.DefaultCountry)
+	config.S.DefaultCountry = "1"
+	ctrl := gomock.NewController(t)
+	mockDataWaitlist := NewMockDataWaitlistV1Adapter(ctrl)
+
+	valid := func() *Waitlist {
+		return &Waitlist{
+			Name:          null.StringFrom("Smith Family"),
+			Email:         null.StringFrom("smith@example.com"),
+			PreferredDays: rawJson(`["2025-12-07","2025-12-14"]`),
+		}
+	}
+	tests := []struct {
+		name    string
+		wl      func() *Waitlist
+		wantErr bool
+		calls   []*gomock.Call
+	}{
+		{
+			"successful",
+			valid,
+			false,
+			[]*gomock.Call{mockDataWaitlist.EXPECT().Create(ctx, gomock.Any()).Return(nil).AnyTimes()},
+		},
+		{
+			"successful - any day",
+			func() *Waitlist { wl := valid(); wl.PreferredDays = nil; return wl },
+			false,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - name",
+			func() *Waitlist { wl := valid(); wl.Name = null.String{}; return wl },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - email",
+			func() *Waitlist { wl := valid(); wl.Email = null.StringFrom("not an email"); return wl },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - blank name",
+			func() *Waitlist { wl := valid(); wl.Name = null.StringFrom("   "); return wl },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - phone",
+			func() *Waitlist { wl := valid(); wl.Phone = null.StringFrom("555-0123"); return wl },
+			true,
+			[]*gomock.Call{},
+		},
+		{
+			"failed - preferred days format",
+			func() *Waitlist { wl := valid(); wl.PreferredDays = rawJson(`["12/07/2025"]`); return wl },
+			true,
+			[]*gomock.Call{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &DomainWaitlistV1{dataWaitlistV1: mockDataWaitlist}
+			err := m.Post(ctx, tt.wl())
+			assert.Equal(t, tt.wantErr, err != nil, "DomainWaitlistV1.Post().%s => expected error: got: %s", tt.name, err)
+		})
+	}
+	// the contact is stored the way tddate.Confirm will want it when the family claims an offer
+	wl := valid()
+	wl.Name = null.StringFrom(" Smith Family ")
+	wl.Email = null.StringFrom(" Smith <smith@example.com> ")
+	wl.Phone = null.StringFrom("(801) 555-0123")
+	m := &DomainWaitlistV1{dataWaitlistV1: mockDataWaitlist}
+	assert.Nil(t, m.Post(ctx, wl))
+	assert.Equal(t, "Smith Family", wl.Name.String)
+	assert.Equal(t, "smith@example.com", wl.Email.String)
+	assert.Equal(t, "+18015550123", wl.Phone.String)
+}
+
+func TestDomainWaitlistV1_SlotReleased(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataWaitlist := NewMockDataWaitlistV1Adapter(ctrl)
+	mockSlotHolder := NewMockSlotHolder(ctrl)
+	mockEmailer := email.NewMockEmailer(ctrl)
+
+	future := time.Now().UTC().Add(48 * time.Hour)
+	released := tddate.TdDate{Id: 7, InterviewerId: 2, DateValue: null.TimeFrom(future)}
+	waiting := func(_ context.Context, wl *Waitlist, _ string, _ int, _ time.Time) error {
+		wl.Id = 3
+		wl.Email = null.StringFrom("smith@example.com")
+		return nil
+	}
+	held := func(_ context.Context, td_ *tddate.TdDate, _ time.Duration) error {
+		td_.Id = 7
+		td_.HoldToken = null.StringFrom("hold-token")
+		td_.ExpiresAt = null.TimeFrom(future.Add(-24 * time.Hour))
+		return nil
+	}
+
+	tests := []struct {
+		name     string
+		released tddate.TdDate
+		calls    func()
+	}{
+		{
+			"successful - offered",
+			released,
+			func() {
+				mockDataWaitlist.EXPECT().NextForDay(ctx, gomock.Any(), future.Format(layoutDate), 7, gomock.Any()).DoAndReturn(waiting)
+				mockSlotHolder.EXPECT().HoldSlot(ctx, gomock.Any(), gomock.Any()).DoAndReturn(held)
+				mockDataWaitlist.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, wl Waitlist) error {
+					assert.Equal(t, int64(7), wl.OfferTdDateId.Int64)
+					assert.Equal(t, "hold-token", wl.OfferToken.String)
+					return nil
+				})
+				mockEmailer.EXPECT().SendWaitlistOffer(ctx, "smith@example.com", gomock.Any(), "hold-token", gomock.Any()).Return(nil).AnyTimes()
+			},
+		},
+		{
+			"no one waiting",
+			released,
+			func() {
+				mockDataWaitlist.EXPECT().NextForDay(ctx, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			"slot in the past",
+			tddate.TdDate{Id: 7, DateValue: null.TimeFrom(time.Now().UTC().Add(-time.Hour))},
+			func() {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.calls()
+			m := &DomainWaitlistV1{dataWaitlistV1: mockDataWaitlist, slotHolder: mockSlotHolder, emailer: mockEmailer}
+			m.SlotReleased(ctx, tt.released)
+		})
+	}
+}
+
+func TestDomainWaitlistV1_Claim(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	mockDataWaitlist := NewMockDataWaitlistV1Adapter(ctrl)
+	mockSlotHolder := NewMockSlotHolder(ctrl)
+
+	offered := func(expiresAt time.Time, claimed bool) func(context.Context, *Waitlist) error {
+		return func(_ context.Context, wl *Waitlist) error {
+			wl.Id = 3
+			wl.Name = null.StringFrom("Smith Family")
+			wl.OfferExpiresAt = null.TimeFrom(expiresAt)
+			if claimed {
+				wl.ClaimedAt = null.TimeFrom(time.Now().UTC())
+			}
+			return nil
+		}
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+		calls   func()
+	}{
+		{
+			"successful",
+			"hold-token",
+			false,
+			func() {
+				mockDataWaitlist.EXPECT().ReadByOfferToken(ctx, gomock.Any()).DoAndReturn(offered(time.Now().UTC().Add(time.Hour), false))
+				mockSlotHolder.EXPECT().Confirm(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, confirm tddate.ConfirmRequest) error {
+					assert.Equal(t, "hold-token", confirm.HoldToken)
+					assert.Equal(t, "Smith Family", confirm.Name.String)
+					return nil
+				})
+				mockDataWaitlist.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			"failed - missing token",
+			"",
+			true,
+			func() {},
+		},
+		{
+			"failed - expired",
+			"hold-token",
+			true,
+			func() {
+				mockDataWaitlist.EXPECT().ReadByOfferToken(ctx, gomock.Any()).DoAndReturn(offered(time.Now().UTC().Add(-time.Minute), false))
+			},
+		},
+		{
+			"failed - already claimed",
+			"hold-token",
+			true,
+			func() {
+				mockDataWaitlist.EXPECT().ReadByOfferToken(ctx, gomock.Any()).DoAndReturn(offered(time.Now().UTC().Add(time.Hour), true))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.calls()
+			m := &DomainWaitlistV1{dataWaitlistV1: mockDataWaitlist, slotHolder: mockSlotHolder}
+			err := m.Claim(ctx, &Waitlist{OfferToken: null.StringFrom(tt.token)})
+			assert.Equal(t, tt.wantErr, err != nil, "DomainWaitlistV1.Claim().%s => expected error: got: %s", tt.name, err)
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain.go
+
+// Package waitlist is a generated GoMock package.
+package waitlist
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	tddate "github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockDataWaitlistV1Adapter is a mock of DataWaitlistV1Adapter interface.
+type MockDataWaitlistV1Adapter struct {
+	ctrl     *gomock.Controller
+	recorder *MockDataWaitlistV1AdapterMockRecorder
+}
+
+// MockDataWaitlistV1AdapterMockRecorder is the mock recorder for MockDataWaitlistV1Adapter.
+type MockDataWaitlistV1AdapterMockRecorder struct {
+	mock *MockDataWaitlistV1Adapter
+}
+
+// NewMockDataWaitlistV1Adapter creates a new mock instance.
+func NewMockDataWaitlistV1Adapter(ctrl *gomock.Controller) *MockDataWaitlistV1Adapter {
+	mock := &MockDataWaitlistV1Adapter{ctrl: ctrl}
+	mock.recorder = &MockDataWaitlistV1AdapterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDataWaitlistV1Adapter) EXPECT() *MockDataWaitlistV1AdapterMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockDataWaitlistV1Adapter) Create(arg0 context.Context, arg1 *Waitlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockDataWaitlistV1AdapterMockRecorder) Create(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockDataWaitlistV1Adapter)(nil).Create), arg0, arg1)
+}
+
+// Delete mocks base method.
+func (m *MockDataWaitlistV1Adapter) Delete(arg0 context.Context, arg1 *Waitlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDataWaitlistV1AdapterMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDataWaitlistV1Adapter)(nil).Delete), arg0, arg1)
+}
+
+// NextForDay mocks base method.
+func (m *MockDataWaitlistV1Adapter) NextForDay(arg0 context.Context, arg1 *Waitlist, arg2 string, arg3 int, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextForDay", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NextForDay indicates an expected call of NextForDay.
+func (mr *MockDataWaitlistV1AdapterMockRecorder) NextForDay(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextForDay", reflect.TypeOf((*MockDataWaitlistV1Adapter)(nil).NextForDay), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Read mocks base method.
+func (m *MockDataWaitlistV1Adapter) Read(arg0 context.Context, arg1 *Waitlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Read", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Read indicates an expected call of Read.
+func (mr *MockDataWaitlistV1AdapterMockRecorder) Read(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockDataWaitlistV1Adapter)(nil).Read), arg0, arg1)
+}
+
+// ReadAll mocks base method.
+func (m *MockDataWaitlistV1Adapter) ReadAll(arg0 context.Context, arg1 *[]Waitlist, arg2 WaitlistParam) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAll", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAll indicates an expected call of ReadAll.
+func (mr *MockDataWaitlistV1AdapterMockRecorder) ReadAll(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAll", reflect.TypeOf((*MockDataWaitlistV1Adapter)(nil).ReadAll), arg0, arg1, arg2)
+}
+
+// ReadByOfferToken mocks base method.
+func (m *MockDataWaitlistV1Adapter) ReadByOfferToken(arg0 context.Context, arg1 *Waitlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadByOfferToken", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReadByOfferToken indicates an expected call of ReadByOfferToken.
+func (mr *MockDataWaitlistV1AdapterMockRecorder) ReadByOfferToken(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByOfferToken", reflect.TypeOf((*MockDataWaitlistV1Adapter)(nil).ReadByOfferToken), arg0, arg1)
+}
+
+// Update mocks base method.
+func (m *MockDataWaitlistV1Adapter) Update(arg0 context.Context, arg1 Waitlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockDataWaitlistV1AdapterMockRecorder) Update(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockDataWaitlistV1Adapter)(nil).Update), arg0, arg1)
+}
+
+// MockSlotHolder is a mock of SlotHolder interface.
+type MockSlotHolder struct {
+	ctrl     *gomock.Controller
+	recorder *MockSlotHolderMockRecorder
+}
+
+// MockSlotHolderMockRecorder is the mock recorder for MockSlotHolder.
+type MockSlotHolderMockRecorder struct {
+	mock *MockSlotHolder
+}
+
+// NewMockSlotHolder creates a new mock instance.
+func NewMockSlotHolder(ctrl *gomock.Controller) *MockSlotHolder {
+	mock := &MockSlotHolder{ctrl: ctrl}
+	mock.recorder = &MockSlotHolderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSlotHolder) EXPECT() *MockSlotHolderMockRecorder {
+	return m.recorder
+}
+
+// Confirm mocks base method.
+func (m *MockSlotHolder) Confirm(arg0 context.Context, arg1 tddate.ConfirmRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Confirm", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Confirm indicates an expected call of Confirm.
+func (mr *MockSlotHolderMockRecorder) Confirm(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Confirm", reflect.TypeOf((*MockSlotHolder)(nil).Confirm), arg0, arg1)
+}
+
+// HoldSlot mocks base method.
+func (m *MockSlotHolder) HoldSlot(arg0 context.Context, arg1 *tddate.TdDate, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HoldSlot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HoldSlot indicates an expected call of HoldSlot.
+func (mr *MockSlotHolderMockRecorder) HoldSlot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HoldSlot", reflect.TypeOf((*MockSlotHolder)(nil).HoldSlot), arg0, arg1, arg2)
+}
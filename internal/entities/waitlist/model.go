@@ -0,0 +1,37 @@
+package waitlist
+
+import (
+	"encoding/json"
+
+	h "github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	Waitlist struct {
+		Id             int              `db:"id" json:"id"`
+		Name           null.String      `db:"name" json:"name"`
+		Email          null.String      `db:"email" json:"email"`
+		Phone          null.String      `db:"phone" json:"phone"`
+		PreferredDays  *json.RawMessage `db:"preferred_days" json:"preferred_days"` // json array of YYYY-MM-DD, empty => any day
+		CreatedAt      null.Time        `db:"created_at" json:"created_at"`
+		OfferTdDateId  null.Int         `db:"offer_td_date_id" json:"offer_td_date_id"`
+		OfferToken     null.String      `db:"offer_token" json:"-"` // the hold token of the offered slot, only ever emailed
+		OfferExpiresAt null.Time        `db:"offer_expires_at" json:"offer_expires_at"`
+		ClaimedAt      null.Time        `db:"claimed_at" json:"claimed_at"`
+	}
+
+	WaitlistParam struct {
+		// TODO: add any other custom params here
+		h.Param
+	}
+)
+
+const (
+	WaitlistConst = "waitlist"
+	layoutDate    = "2006-01-02"
+)
+
+func InitStorageV1() DataWaitlistV1Adapter {
+	return InitSQLV1()
+}
@@ -0,0 +1,179 @@
+package waitlist
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+	r "github.com/blackflagsoftware/tithe-declare/internal/middleware/route"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/handler"
+	"github.com/labstack/echo/v4"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	RestWaitlistV1 struct{}
+)
+
+var (
+	restV1   RestWaitlistV1
+	domainV1 *DomainWaitlistV1
+)
+
+func InitializeWaitlistV1(tdDomain *tddate.DomainTdDateV1) *DomainWaitlistV1 {
+	storV1 := InitStorageV1()
+	domainV1 = NewDomainWaitlistV1(storV1, tdDomain)
+	restV1 = *NewRestWaitlistV1()
+	tdDomain.AddSlotReleaseListener(domainV1)
+	return domainV1
+}
+
+func RegisterWaitlist(eg *echo.Group) {
+	r.RegisterAndAdd(eg, http.MethodGet, "/waitlist/:id", Get)
+	r.RegisterAndAdd(eg, http.MethodPost, "/waitlist/search", Search)
+	r.RegisterAndAdd(eg, http.MethodPost, "/waitlist", Post)
+	r.RegisterAndAdd(eg, http.MethodPatch, "/waitlist", Patch)
+	r.RegisterAndAdd(eg, http.MethodDelete, "/waitlist/:id", Delete)
+	r.RegisterAndAdd(eg, http.MethodPost, "/waitlist/claim/:token", Claim)
+}
+
+func Get(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Get(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Search(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Search(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Post(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Post(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Patch(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Patch(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Delete(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Delete(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+func Claim(c echo.Context) error {
+	version := c.Get("version").(string)
+	if version == "v1" {
+		return restV1.Claim(c)
+	}
+	return handler.FormatResponseWithError(c, ae.RouteVersionNotFoundError(c.Request().URL.Path, version))
+}
+
+// V1
+func NewRestWaitlistV1() *RestWaitlistV1 {
+	return &RestWaitlistV1{}
+}
+
+func (h *RestWaitlistV1) Get(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	waitlist := &Waitlist{Id: int(id)}
+	if err := domainV1.Get(ctx, waitlist); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *waitlist, nil)
+}
+
+func (h *RestWaitlistV1) Search(c echo.Context) error {
+	ctx := context.Background()
+	param := WaitlistParam{}
+	if err := c.Bind(&param); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	waitlists := &[]Waitlist{}
+	totalCount, err := domainV1.Search(ctx, waitlists, param)
+	if err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *waitlists, &totalCount)
+}
+
+func (h *RestWaitlistV1) Post(c echo.Context) error {
+	ctx := context.Background()
+	wl := Waitlist{}
+	if err := c.Bind(&wl); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Post(ctx, &wl); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 201, wl, nil)
+}
+
+func (h *RestWaitlistV1) Patch(c echo.Context) error {
+	ctx := context.Background()
+	wl := Waitlist{}
+	if err := c.Bind(&wl); err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	if err := domainV1.Patch(ctx, wl); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestWaitlistV1) Delete(c echo.Context) error {
+	ctx := context.Background()
+	idStr := c.Param("id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		bindErr := ae.BindError(err)
+		return handler.FormatResponseWithError(c, bindErr)
+	}
+	waitlist := &Waitlist{Id: int(id)}
+	if err := domainV1.Delete(ctx, waitlist); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return c.NoContent(http.StatusOK)
+}
+
+func (h *RestWaitlistV1) Claim(c echo.Context) error {
+	ctx := context.Background()
+	wl := &Waitlist{OfferToken: null.StringFrom(c.Param("token"))}
+	if err := domainV1.Claim(ctx, wl); err != nil {
+		apiError := err.(ae.ApiError)
+		return handler.FormatResponseWithError(c, apiError)
+	}
+	return handler.FormatResponse(c, 200, *wl, nil)
+}
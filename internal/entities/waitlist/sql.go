@@ -0,0 +1,206 @@
+package waitlist
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	stor "github.com/blackflagsoftware/tithe-declare/internal/storage"
+	usql "github.com/blackflagsoftware/tithe-declare/internal/util/sql"
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	SQLWaitlistV1 struct {
+		DB *sqlx.DB
+	}
+)
+
+func InitSQLV1() *SQLWaitlistV1 {
+	db := stor.InitStorage()
+	return &SQLWaitlistV1{DB: db}
+}
+
+func (d *SQLWaitlistV1) Read(ctx context.Context, wl *Waitlist) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			email,
+			phone,
+			preferred_days,
+			created_at,
+			offer_td_date_id,
+			offer_token,
+			offer_expires_at,
+			claimed_at
+		FROM waitlist WHERE id = $1`
+	if errDB := d.DB.Get(wl, sqlGet, wl.Id); errDB != nil {
+		return ae.DBError("Waitlist Get: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLWaitlistV1) ReadAll(ctx context.Context, wl *[]Waitlist, param WaitlistParam) (int, error) {
+	searchStmt, args := usql.BuildSearchString(param.Param, false) // false => include the where clause, see internal/util/sql.go
+	sqlSearch := fmt.Sprintf(`
+		SELECT
+			id,
+			name,
+			email,
+			phone,
+			preferred_days,
+			created_at,
+			offer_td_date_id,
+			offer_token,
+			offer_expires_at,
+			claimed_at
+		FROM waitlist
+		%s
+		ORDER BY %s %s`, searchStmt, param.Sort, param.PaginationString)
+	sqlSearch = d.DB.Rebind(sqlSearch)
+	if errDB := d.DB.Select(wl, sqlSearch, args...); errDB != nil {
+		return 0, ae.DBError("Waitlist ReadAll: unable to select records.", errDB)
+	}
+	sqlCount := fmt.Sprintf(`
+		SELECT
+			COUNT(*)
+		FROM waitlist
+		%s`, searchStmt)
+	var count int
+	sqlCount = d.DB.Rebind(sqlCount)
+	if errDB := d.DB.Get(&count, sqlCount, args...); errDB != nil {
+		return 0, ae.DBError("waitlist ReadAll: unable to select count.", errDB)
+	}
+	return count, nil
+}
+
+func (d *SQLWaitlistV1) Create(ctx context.Context, wl *Waitlist) error {
+	count, errCount := d.count()
+	if errCount != nil {
+		return errCount
+	}
+	wl.Id = count
+	sqlPost := `
+		INSERT INTO waitlist (
+			id,
+			name,
+			email,
+			phone,
+			preferred_days,
+			created_at,
+			offer_td_date_id,
+			offer_token,
+			offer_expires_at,
+			claimed_at
+		) VALUES (
+			:id,
+			:name,
+			:email,
+			:phone,
+			:preferred_days,
+			:created_at,
+			:offer_td_date_id,
+			:offer_token,
+			:offer_expires_at,
+			:claimed_at
+		)`
+	_, errDB := d.DB.NamedExec(sqlPost, wl)
+	if errDB != nil {
+		return ae.DBError("Waitlist Post: unable to insert record.", errDB)
+	}
+
+	return nil
+}
+
+func (d *SQLWaitlistV1) Update(ctx context.Context, wl Waitlist) error {
+	sqlPatch := `
+		UPDATE waitlist SET
+			name = :name,
+			email = :email,
+			phone = :phone,
+			preferred_days = :preferred_days,
+			offer_td_date_id = :offer_td_date_id,
+			offer_token = :offer_token,
+			offer_expires_at = :offer_expires_at,
+			claimed_at = :claimed_at
+		WHERE id = :id`
+	if _, errDB := d.DB.NamedExec(sqlPatch, wl); errDB != nil {
+		return ae.DBError("Waitlist Patch: unable to update record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLWaitlistV1) Delete(ctx context.Context, wl *Waitlist) error {
+	sqlDelete := `
+		DELETE FROM waitlist WHERE id = $1`
+	if _, errDB := d.DB.Exec(sqlDelete, wl.Id); errDB != nil {
+		return ae.DBError("Waitlist Delete: unable to delete record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLWaitlistV1) ReadByOfferToken(ctx context.Context, wl *Waitlist) error {
+	sqlGet := `
+		SELECT
+			id,
+			name,
+			email,
+			phone,
+			preferred_days,
+			created_at,
+			offer_td_date_id,
+			offer_token,
+			offer_expires_at,
+			claimed_at
+		FROM waitlist WHERE offer_token = $1`
+	if errDB := d.DB.Get(wl, sqlGet, wl.OfferToken); errDB != nil {
+		if errDB == sql.ErrNoRows {
+			return ae.HoldTokenInvalidError()
+		}
+		return ae.DBError("Waitlist ReadByOfferToken: unable to get record.", errDB)
+	}
+	return nil
+}
+
+// finds the longest waiting person that wants day (or any day), hasn't claimed a slot, doesn't have an open offer
+// and wasn't already offered tdDateId; wl is left as is when no one matches
+func (d *SQLWaitlistV1) NextForDay(ctx context.Context, wl *Waitlist, day string, tdDateId int, now time.Time) error {
+	sqlNext := `
+		SELECT
+			id,
+			name,
+			email,
+			phone,
+			preferred_days,
+			created_at,
+			offer_td_date_id,
+			offer_token,
+			offer_expires_at,
+			claimed_at
+		FROM waitlist
+		WHERE claimed_at IS NULL
+			AND (offer_expires_at IS NULL OR offer_expires_at <= $1)
+			AND (offer_td_date_id IS NULL OR offer_td_date_id != $2)
+			AND (preferred_days IS NULL OR json_array_length(preferred_days) = 0
+				OR EXISTS (SELECT 1 FROM json_each(waitlist.preferred_days) WHERE json_each.value = $3))
+		ORDER BY created_at, id
+		LIMIT 1`
+	if errDB := d.DB.Get(wl, sqlNext, now, tdDateId, day); errDB != nil {
+		if errDB == sql.ErrNoRows {
+			return nil
+		}
+		return ae.DBError("Waitlist NextForDay: unable to get record.", errDB)
+	}
+	return nil
+}
+
+func (d *SQLWaitlistV1) count() (int, error) {
+	count := 0
+	if errDB := d.DB.Get(&count, "SELECT COALESCE(MAX(id), 0) FROM waitlist"); errDB != nil {
+		return 0, ae.DBError("Waitlist count: unable to get count.", errDB)
+	}
+	return count + 1, nil
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"crypto/ed25519"
 	"crypto/rsa"
+	"crypto/subtle"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/pem"
@@ -49,6 +50,21 @@ func BasicAuthFunc() middleware.BasicAuthValidator {
 	}
 }
 
+// for routes outside the versioned group that can only send basic auth (i.e.: calendar subscriptions)
+// unlike BasicAuthFunc the credentials must match
+func StrictBasicAuthConfig() middleware.BasicAuthConfig {
+	return middleware.BasicAuthConfig{
+		Validator: func(userName, userPwd string, c echo.Context) (bool, error) {
+			if config.A.BasicAuthUser == "" {
+				return false, nil
+			}
+			validUser := subtle.ConstantTimeCompare([]byte(config.A.BasicAuthUser), []byte(userName)) == 1
+			validPwd := subtle.ConstantTimeCompare([]byte(config.A.BasicAuthPwd), []byte(userPwd)) == 1
+			return validUser && validPwd, nil
+		},
+	}
+}
+
 func SkipperBasicFunc() func(echo.Context) bool {
 	return func(c echo.Context) bool {
 		bearer := "Bearer"
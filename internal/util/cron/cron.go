@@ -0,0 +1,149 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed standard 5 field cron expression: minute hour day-of-month month day-of-week
+// fields support *, lists (1,15), ranges (1-5), steps (*/15, 0-30/10) and month/day names (jan, mon)
+type Schedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+}
+
+type field struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	fieldMinute = field{0, 59, nil}
+	fieldHour   = field{0, 23, nil}
+	fieldDom    = field{1, 31, nil}
+	fieldMonth  = field{1, 12, map[string]int{"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6, "jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12}}
+	fieldDow    = field{0, 7, map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}}
+)
+
+func Parse(expr string) (Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != 5 {
+		return Schedule{}, fmt.Errorf("cron: expected 5 fields, got %d", len(parts))
+	}
+	s := Schedule{}
+	var err error
+	if s.minute, _, err = parseField(parts[0], fieldMinute); err != nil {
+		return Schedule{}, err
+	}
+	if s.hour, _, err = parseField(parts[1], fieldHour); err != nil {
+		return Schedule{}, err
+	}
+	if s.dom, s.domStar, err = parseField(parts[2], fieldDom); err != nil {
+		return Schedule{}, err
+	}
+	if s.month, _, err = parseField(parts[3], fieldMonth); err != nil {
+		return Schedule{}, err
+	}
+	if s.dow, s.dowStar, err = parseField(parts[4], fieldDow); err != nil {
+		return Schedule{}, err
+	}
+	if s.dow&(1<<7) > 0 {
+		// 7 is also sunday
+		s.dow |= 1
+	}
+	return s, nil
+}
+
+// Next is the first time after t (to the minute) that matches, in t's location
+// a zero time is returned if nothing matches within 5 years (i.e.: feb 30)
+func (s Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// same as cron, if both day fields are restricted either one matching is enough
+func (s Schedule) dayMatches(t time.Time) bool {
+	domMatch := s.dom&(1<<uint(t.Day())) > 0
+	dowMatch := s.dow&(1<<uint(t.Weekday())) > 0
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+func parseField(expr string, f field) (uint64, bool, error) {
+	var bits uint64
+	star := false
+	for _, item := range strings.Split(expr, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step < 1 {
+				return 0, false, fmt.Errorf("cron: invalid step: %s", item)
+			}
+		}
+		low, high := f.min, f.max
+		switch {
+		case rangeExpr == "*":
+			star = !hasStep
+		case strings.Contains(rangeExpr, "-"):
+			lowExpr, highExpr, _ := strings.Cut(rangeExpr, "-")
+			var err error
+			if low, err = f.value(lowExpr); err != nil {
+				return 0, false, err
+			}
+			if high, err = f.value(highExpr); err != nil {
+				return 0, false, err
+			}
+		default:
+			var err error
+			if low, err = f.value(rangeExpr); err != nil {
+				return 0, false, err
+			}
+			if !hasStep {
+				high = low
+			}
+		}
+		if low > high {
+			return 0, false, fmt.Errorf("cron: invalid range: %s", item)
+		}
+		for i := low; i <= high; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, star, nil
+}
+
+func (f field) value(expr string) (int, error) {
+	if v, ok := f.names[strings.ToLower(expr)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(expr)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("cron: value out of range: %s", expr)
+	}
+	return v, nil
+}
@@ -0,0 +1,60 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{"every minute", "* * * * *", false},
+		{"weekly", "0 23 * * fri", false},
+		{"lists ranges steps", "0,30 8-17/2 1-15 jan-jun mon-fri", false},
+		{"sunday as 7", "0 9 * * 7", false},
+		{"failed - fields", "0 23 * *", true},
+		{"failed - out of range", "60 * * * *", true},
+		{"failed - bad step", "*/0 * * * *", true},
+		{"failed - backwards range", "0 17-8 * * *", true},
+		{"failed - name", "0 0 * * funday", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.expr)
+			assert.Equal(t, tt.wantErr, err != nil, "Parse().%s => expected error: got: %s", tt.name, err)
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	denver, _ := time.LoadLocation("America/Denver")
+	tests := []struct {
+		name string
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"every minute", "* * * * *", time.Date(2025, 12, 5, 10, 4, 30, 0, time.UTC), time.Date(2025, 12, 5, 10, 5, 0, 0, time.UTC)},
+		{"later the same day", "0 23 * * fri", time.Date(2025, 12, 5, 10, 0, 0, 0, time.UTC), time.Date(2025, 12, 5, 23, 0, 0, 0, time.UTC)},
+		{"exactly on the time moves on", "0 23 * * fri", time.Date(2025, 12, 5, 23, 0, 0, 0, time.UTC), time.Date(2025, 12, 12, 23, 0, 0, 0, time.UTC)},
+		{"step", "*/15 * * * *", time.Date(2025, 12, 5, 10, 16, 0, 0, time.UTC), time.Date(2025, 12, 5, 10, 30, 0, 0, time.UTC)},
+		{"month rollover", "0 0 1 * *", time.Date(2025, 12, 5, 10, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"day of month or day of week", "0 9 1 * mon", time.Date(2025, 12, 2, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 8, 9, 0, 0, 0, time.UTC)},
+		{"sunday as 7", "0 9 * * 7", time.Date(2025, 12, 5, 0, 0, 0, 0, time.UTC), time.Date(2025, 12, 7, 9, 0, 0, 0, time.UTC)},
+		{"local time", "0 23 * * fri", time.Date(2025, 12, 5, 10, 0, 0, 0, denver), time.Date(2025, 12, 5, 23, 0, 0, 0, denver)},
+		{"never", "0 0 30 feb *", time.Date(2025, 12, 5, 10, 0, 0, 0, time.UTC), time.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Parse(tt.expr)
+			assert.Nil(t, err, "Parse().%s => expected not error; got: %s", tt.name, err)
+			got := s.Next(tt.from)
+			assert.True(t, tt.want.Equal(got), "Schedule.Next().%s => got: %s, want: %s", tt.name, got, tt.want)
+		})
+	}
+}
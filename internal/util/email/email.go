@@ -3,10 +3,12 @@ package email
 import (
 	"context"
 	"fmt"
-	"net/smtp"
+	"net/url"
+	"time"
 
 	"github.com/blackflagsoftware/tithe-declare/config"
 	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/ics"
 )
 
 //go:generate mockgen -source=email.go -destination=mock.go -package=email
@@ -14,63 +16,97 @@ type (
 	Emailer interface {
 		SendReset(context.Context, string, string) error
 		SendReminder(context.Context, []string, string) error
-		SendIndividualReminder(context.Context, []string, string) error
+		SendIndividualReminder(context.Context, []string, string, []byte) error
+		SendManageLink(context.Context, string, string, string, []byte) error
+		SendWaitlistOffer(context.Context, string, string, string, time.Time) error
+		SendConfirmation(context.Context, []string, string) error
+		SendNoShowFollowUp(context.Context, []string, string) error
+		SendRescheduleRequest(context.Context, string, string, string) error
 	}
 
-	Email struct{}
+	Email struct {
+		transport Transport
+	}
+
+	Attachment struct {
+		FileName    string
+		ContentType string
+		Content     []byte
+	}
 )
 
 func EmailInit() Emailer {
-	if config.E.Host != "" {
-		return &Email{}
-	}
-	return &MockEmailer{}
+	return &Email{transport: TransportInit()}
 }
 
 func (e Email) SendReset(ctx context.Context, toEmail, resetToken string) error {
-	from := config.E.From
-	pwd := config.E.Pwd
-	host := config.E.Host
-	port := config.E.GetEmailPort()
-
-	auth := smtp.PlainAuth("", from, pwd, host)
-	to := []string{toEmail}
-	msg := []byte(fmt.Sprintf("To: %s\r\nSubject: Reset Password Instructions\r\n\r\nTo reset your password: %s?email=%s&token=%s\r\n", toEmail, config.E.ResetUrl, toEmail, resetToken))
-	if err := smtp.SendMail(fmt.Sprintf("%s:%d", host, port), auth, from, to, msg); err != nil {
-		logging.Default.Println("unable to send email:", err)
-		return err
-	}
-	return nil
+	data := TemplateData{Url: fmt.Sprintf("%s?email=%s&token=%s", config.E.ResetUrl, url.QueryEscape(toEmail), resetToken)}
+	return e.send(ctx, templateReset, []string{toEmail}, data)
 }
 
+// SendReminder sends the leadership digest
 func (e Email) SendReminder(ctx context.Context, toEmail []string, body string) error {
-	from := config.E.From
-	pwd := config.E.Pwd
-	host := config.E.Host
-	port := config.E.GetEmailPort()
-
-	auth := smtp.PlainAuth("", from, pwd, host)
-	to := toEmail
-	msg := []byte(fmt.Sprintf("To: %s\r\nSubject: Upcoming Tithing Declarations\r\n\r\n%s\r\n", toEmail, body))
-	if err := smtp.SendMail(fmt.Sprintf("%s:%d", host, port), auth, from, to, msg); err != nil {
-		logging.Default.Println("unable to send email for SendReminder:", err)
-		return err
+	return e.send(ctx, templateDigest, toEmail, TemplateData{Body: body})
+}
+
+// SendIndividualReminder sends the reminder to the family, calendar (if any) is attached as an invite.ics
+func (e Email) SendIndividualReminder(ctx context.Context, toEmail []string, body string, calendar []byte) error {
+	return e.send(ctx, templateReminder, toEmail, TemplateData{Body: body}, calendarAttachment(calendar)...)
+}
+
+// SendManageLink sends the link a family uses to cancel or reschedule their declaration
+func (e Email) SendManageLink(ctx context.Context, toEmail, appointment, manageToken string, calendar []byte) error {
+	data := TemplateData{Appointment: appointment, Url: fmt.Sprintf("%s?token=%s", config.E.ManageUrl, manageToken)}
+	return e.send(ctx, templateManageLink, []string{toEmail}, data, calendarAttachment(calendar)...)
+}
+
+// SendWaitlistOffer lets someone on the waitlist know a time opened up, it is held for them until expiresAt
+func (e Email) SendWaitlistOffer(ctx context.Context, toEmail, appointment, claimToken string, expiresAt time.Time) error {
+	data := TemplateData{
+		Appointment: appointment,
+		Url:         fmt.Sprintf("%s?token=%s", config.E.ClaimUrl, claimToken),
+		ExpiresAt:   expiresAt.In(config.Sch.GetTimezone()).Format("Monday, January 2, 2006 03:04 PM MST"),
 	}
-	return nil
+	return e.send(ctx, templateWaitlist, []string{toEmail}, data)
+}
+
+// SendConfirmation lets leadership know about a declaration as soon as it is booked
+func (e Email) SendConfirmation(ctx context.Context, toEmail []string, body string) error {
+	return e.send(ctx, templateConfirmation, toEmail, TemplateData{Body: body})
+}
+
+// SendNoShowFollowUp invites a family that missed their declaration to book another time
+func (e Email) SendNoShowFollowUp(ctx context.Context, toEmail []string, appointment string) error {
+	return e.send(ctx, templateNoShow, toEmail, TemplateData{Appointment: appointment, Url: config.E.BookUrl})
+}
+
+// SendRescheduleRequest asks a family to move their declaration, i.e.: the day was cancelled; without a manage token they are sent to book again
+func (e Email) SendRescheduleRequest(ctx context.Context, toEmail, appointment, manageToken string) error {
+	data := TemplateData{Appointment: appointment, Url: config.E.BookUrl}
+	if manageToken != "" {
+		data.Url = fmt.Sprintf("%s?token=%s", config.E.ManageUrl, manageToken)
+	}
+	return e.send(ctx, templateReschedule, []string{toEmail}, data)
 }
 
-func (e Email) SendIndividualReminder(ctx context.Context, toEmail []string, body string) error {
+// send renders the named template and hands it to the transport
+func (e Email) send(ctx context.Context, name string, to []string, data TemplateData, attachments ...Attachment) error {
 	from := config.E.From
-	pwd := config.E.Pwd
-	host := config.E.Host
-	port := config.E.GetEmailPort()
-
-	auth := smtp.PlainAuth("", from, pwd, host)
-	to := toEmail
-	msg := []byte(fmt.Sprintf("To: %s\r\nSubject: Tithing Declaration Reminder\r\n\r\n%s\r\n", toEmail, body))
-	if err := smtp.SendMail(fmt.Sprintf("%s:%d", host, port), auth, from, to, msg); err != nil {
-		logging.Default.Println("unable to send email for SendIndividualReminder:", err)
+	msg, err := render(name, data)
+	if err != nil {
+		logging.Default.Printf("unable to render email template: %s: %s", name, err)
+		return err
+	}
+	if err := e.transport.Send(ctx, from, to, buildMessage(from, to, msg, attachments...)); err != nil {
+		logging.Default.Printf("unable to send email: %s: %s", name, err)
 		return err
 	}
 	return nil
 }
+
+func calendarAttachment(calendar []byte) []Attachment {
+	if len(calendar) == 0 {
+		return nil
+	}
+	return []Attachment{{FileName: "invite.ics", ContentType: ics.ContentType + "; method=PUBLISH", Content: calendar}}
+}
@@ -0,0 +1,81 @@
+package email
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// buildMessage builds the raw message: the text and html as multipart/alternative
+// with attachments it is wrapped in multipart/mixed, the alternative part first
+func buildMessage(from string, to []string, msg rendered, attachments ...Attachment) []byte {
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "From: %s\r\n", from)
+	fmt.Fprintf(buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(buf, "Message-ID: %s\r\n", messageId(from))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+
+	alternative := &bytes.Buffer{}
+	aw := multipart.NewWriter(alternative)
+	writeQuotedPrintable(aw, "text/plain; charset=utf-8", msg.Text)
+	writeQuotedPrintable(aw, "text/html; charset=utf-8", msg.Html)
+	aw.Close()
+	alternativeType := mime.FormatMediaType("multipart/alternative", map[string]string{"boundary": aw.Boundary()})
+	if len(attachments) == 0 {
+		fmt.Fprintf(buf, "Content-Type: %s\r\n\r\n", alternativeType)
+		buf.Write(alternative.Bytes())
+		return buf.Bytes()
+	}
+
+	mw := multipart.NewWriter(buf)
+	fmt.Fprintf(buf, "Content-Type: %s\r\n\r\n", mime.FormatMediaType("multipart/mixed", map[string]string{"boundary": mw.Boundary()}))
+	part, _ := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {alternativeType}})
+	part.Write(alternative.Bytes())
+	for _, att := range attachments {
+		header := textproto.MIMEHeader{
+			"Content-Type":              {att.ContentType},
+			"Content-Transfer-Encoding": {"base64"},
+			"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": att.FileName})},
+		}
+		part, _ := mw.CreatePart(header)
+		encoded := base64.StdEncoding.EncodeToString(att.Content)
+		for len(encoded) > 76 {
+			part.Write([]byte(encoded[:76] + "\r\n"))
+			encoded = encoded[76:]
+		}
+		part.Write([]byte(encoded + "\r\n"))
+	}
+	mw.Close()
+	return buf.Bytes()
+}
+
+func writeQuotedPrintable(mw *multipart.Writer, contentType, body string) {
+	part, _ := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	qp := quotedprintable.NewWriter(part)
+	qp.Write([]byte(body))
+	qp.Close()
+}
+
+// messageId is random, under the domain of the from address
+func messageId(from string) string {
+	domain := "localhost"
+	if addr := envelopeAddress(from); strings.Contains(addr, "@") {
+		domain = addr[strings.LastIndex(addr, "@")+1:]
+	}
+	b := make([]byte, 16)
+	rand.Read(b)
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(b), domain)
+}
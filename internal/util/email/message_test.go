@@ -0,0 +1,93 @@
+package email
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	for _, name := range []string{templateReset, templateReminder, templateDigest, templateConfirmation, templateManageLink, templateWaitlist, templateNoShow, templateReschedule} {
+		t.Run(name, func(t *testing.T) {
+			msg, err := render(name, TemplateData{Body: "line one\nline <two>", Appointment: "Sunday, December 7, 2025, 09:00 AM - 09:20 AM", Url: "https://example.com/x?token=abc"})
+			assert.Nil(t, err)
+			assert.NotEmpty(t, msg.Subject)
+			assert.NotContains(t, msg.Text, "subject", "the subject block should not be in the body")
+			assert.Contains(t, msg.Html, "<title>"+msg.Subject+"</title>")
+		})
+	}
+
+	msg, _ := render(templateDigest, TemplateData{Body: "line one\nline <two>"})
+	assert.Equal(t, "line one\nline <two>\r\n", msg.Text)
+	assert.Contains(t, msg.Html, "line one<br>")
+	assert.Contains(t, msg.Html, "line &lt;two&gt;<br>", "html should be escaped")
+}
+
+func TestRender_Override(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "reset.txt"), []byte(`{{define "subject"}}Password Help{{end}}Go to {{.Url}}`), 0644)
+	config.E.TemplateDir = dir
+	defer func() { config.E.TemplateDir = "" }()
+
+	msg, err := render(templateReset, TemplateData{Url: "https://example.com/reset"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Password Help", msg.Subject)
+	assert.Equal(t, "Go to https://example.com/reset\r\n", msg.Text)
+	assert.Contains(t, msg.Html, `href="https://example.com/reset"`, "no override for the html, the built in one is used")
+}
+
+func TestBuildMessage(t *testing.T) {
+	msg := rendered{Subject: "Tithing Declaration Reminder", Text: "plain body\r\n", Html: "<p>html body</p>"}
+
+	tests := []struct {
+		name        string
+		attachments []Attachment
+		wantType    string
+	}{
+		{"alternative", nil, "multipart/alternative"},
+		{"mixed", []Attachment{{FileName: "invite.ics", ContentType: "text/calendar", Content: []byte("BEGIN:VCALENDAR")}}, "multipart/mixed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := buildMessage("Ward Clerk <clerk@example.com>", []string{"a@example.com", "b@example.com"}, msg, tt.attachments...)
+			m, err := mail.ReadMessage(bytes.NewReader(raw))
+			assert.Nil(t, err)
+			assert.Equal(t, "Ward Clerk <clerk@example.com>", m.Header.Get("From"))
+			assert.Equal(t, "a@example.com, b@example.com", m.Header.Get("To"))
+			assert.Equal(t, msg.Subject, m.Header.Get("Subject"))
+			assert.True(t, strings.HasSuffix(m.Header.Get("Message-ID"), "@example.com>"))
+			_, err = m.Header.Date()
+			assert.Nil(t, err)
+			mediaType, params, _ := mime.ParseMediaType(m.Header.Get("Content-Type"))
+			assert.Equal(t, tt.wantType, mediaType)
+
+			body := m.Body
+			mr := multipart.NewReader(body, params["boundary"])
+			if mediaType == "multipart/mixed" {
+				part, _ := mr.NextPart()
+				_, altParams, _ := mime.ParseMediaType(part.Header.Get("Content-Type"))
+				alternative, _ := io.ReadAll(part)
+				attachment, _ := mr.NextPart()
+				assert.Equal(t, "invite.ics", attachment.FileName())
+				mr = multipart.NewReader(bytes.NewReader(alternative), altParams["boundary"])
+			}
+			text, _ := mr.NextPart()
+			textBody, _ := io.ReadAll(quotedprintable.NewReader(text))
+			assert.Equal(t, "plain body\r\n", string(textBody))
+			html, _ := mr.NextPart()
+			assert.Equal(t, "text/html; charset=utf-8", html.Header.Get("Content-Type"))
+			htmlBody, _ := io.ReadAll(quotedprintable.NewReader(html))
+			assert.Equal(t, msg.Html, string(htmlBody))
+		})
+	}
+}
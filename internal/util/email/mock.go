@@ -7,6 +7,7 @@ package email
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -34,18 +35,60 @@ func (m *MockEmailer) EXPECT() *MockEmailerMockRecorder {
 	return m.recorder
 }
 
+// SendConfirmation mocks base method.
+func (m *MockEmailer) SendConfirmation(arg0 context.Context, arg1 []string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendConfirmation", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendConfirmation indicates an expected call of SendConfirmation.
+func (mr *MockEmailerMockRecorder) SendConfirmation(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendConfirmation", reflect.TypeOf((*MockEmailer)(nil).SendConfirmation), arg0, arg1, arg2)
+}
+
 // SendIndividualReminder mocks base method.
-func (m *MockEmailer) SendIndividualReminder(arg0 context.Context, arg1 []string, arg2 string) error {
+func (m *MockEmailer) SendIndividualReminder(arg0 context.Context, arg1 []string, arg2 string, arg3 []byte) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendIndividualReminder", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SendIndividualReminder", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SendIndividualReminder indicates an expected call of SendIndividualReminder.
-func (mr *MockEmailerMockRecorder) SendIndividualReminder(arg0, arg1, arg2 interface{}) *gomock.Call {
+func (mr *MockEmailerMockRecorder) SendIndividualReminder(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendIndividualReminder", reflect.TypeOf((*MockEmailer)(nil).SendIndividualReminder), arg0, arg1, arg2, arg3)
+}
+
+// SendManageLink mocks base method.
+func (m *MockEmailer) SendManageLink(arg0 context.Context, arg1, arg2, arg3 string, arg4 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendManageLink", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendManageLink indicates an expected call of SendManageLink.
+func (mr *MockEmailerMockRecorder) SendManageLink(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendManageLink", reflect.TypeOf((*MockEmailer)(nil).SendManageLink), arg0, arg1, arg2, arg3, arg4)
+}
+
+// SendNoShowFollowUp mocks base method.
+func (m *MockEmailer) SendNoShowFollowUp(arg0 context.Context, arg1 []string, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNoShowFollowUp", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendNoShowFollowUp indicates an expected call of SendNoShowFollowUp.
+func (mr *MockEmailerMockRecorder) SendNoShowFollowUp(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendIndividualReminder", reflect.TypeOf((*MockEmailer)(nil).SendIndividualReminder), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNoShowFollowUp", reflect.TypeOf((*MockEmailer)(nil).SendNoShowFollowUp), arg0, arg1, arg2)
 }
 
 // SendReminder mocks base method.
@@ -62,6 +105,20 @@ func (mr *MockEmailerMockRecorder) SendReminder(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReminder", reflect.TypeOf((*MockEmailer)(nil).SendReminder), arg0, arg1, arg2)
 }
 
+// SendRescheduleRequest mocks base method.
+func (m *MockEmailer) SendRescheduleRequest(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendRescheduleRequest", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendRescheduleRequest indicates an expected call of SendRescheduleRequest.
+func (mr *MockEmailerMockRecorder) SendRescheduleRequest(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRescheduleRequest", reflect.TypeOf((*MockEmailer)(nil).SendRescheduleRequest), arg0, arg1, arg2, arg3)
+}
+
 // SendReset mocks base method.
 func (m *MockEmailer) SendReset(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -75,3 +132,17 @@ func (mr *MockEmailerMockRecorder) SendReset(arg0, arg1, arg2 interface{}) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReset", reflect.TypeOf((*MockEmailer)(nil).SendReset), arg0, arg1, arg2)
 }
+
+// SendWaitlistOffer mocks base method.
+func (m *MockEmailer) SendWaitlistOffer(arg0 context.Context, arg1, arg2, arg3 string, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWaitlistOffer", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendWaitlistOffer indicates an expected call of SendWaitlistOffer.
+func (mr *MockEmailerMockRecorder) SendWaitlistOffer(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWaitlistOffer", reflect.TypeOf((*MockEmailer)(nil).SendWaitlistOffer), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,106 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+)
+
+type (
+	// TemplateData is what the email templates are given, not every message uses every field
+	TemplateData struct {
+		Subject     string // set from the text template's "subject" block, for the html layout
+		Body        string // already rendered text, e.g.: from a reminder rule
+		Appointment string
+		Url         string
+		ExpiresAt   string
+	}
+
+	rendered struct {
+		Subject string
+		Text    string
+		Html    string
+	}
+)
+
+const (
+	templateReset        = "reset"
+	templateReminder     = "reminder"
+	templateDigest       = "digest"
+	templateConfirmation = "confirmation"
+	templateManageLink   = "manage-link"
+	templateWaitlist     = "waitlist-offer"
+	templateNoShow       = "no-show"
+	templateReschedule   = "reschedule-request"
+	templateLayout       = "layout.html"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var templateFuncs = map[string]any{
+	"lines": func(s string) []string { return strings.Split(strings.TrimRight(s, "\r\n"), "\n") },
+}
+
+// render builds the subject, text and html of a message from <name>.txt and <name>.html
+// the .txt defines the subject in a "subject" block, the .html is placed inside layout.html
+func render(name string, data TemplateData) (rendered, error) {
+	textSrc, err := readTemplate(name + ".txt")
+	if err != nil {
+		return rendered{}, err
+	}
+	textTmpl, err := texttemplate.New(name).Funcs(templateFuncs).Parse(textSrc)
+	if err != nil {
+		return rendered{}, err
+	}
+	subject := &bytes.Buffer{}
+	if err := textTmpl.ExecuteTemplate(subject, "subject", data); err != nil {
+		return rendered{}, err
+	}
+	data.Subject = strings.TrimSpace(subject.String())
+	text := &bytes.Buffer{}
+	if err := textTmpl.Execute(text, data); err != nil {
+		return rendered{}, err
+	}
+
+	layoutSrc, err := readTemplate(templateLayout)
+	if err != nil {
+		return rendered{}, err
+	}
+	htmlSrc, err := readTemplate(name + ".html")
+	if err != nil {
+		return rendered{}, err
+	}
+	htmlTmpl, err := htmltemplate.New(templateLayout).Funcs(templateFuncs).Parse(layoutSrc)
+	if err != nil {
+		return rendered{}, err
+	}
+	if _, err := htmlTmpl.New("content").Parse(htmlSrc); err != nil {
+		return rendered{}, err
+	}
+	html := &bytes.Buffer{}
+	if err := htmlTmpl.Execute(html, data); err != nil {
+		return rendered{}, err
+	}
+	return rendered{Subject: data.Subject, Text: strings.TrimSpace(text.String()) + "\r\n", Html: html.String()}, nil
+}
+
+// readTemplate uses the file in TITHE_DECLARE_EMAIL_TEMPLATE_DIR if there is one, otherwise the built in one
+func readTemplate(fileName string) (string, error) {
+	if config.E.TemplateDir != "" {
+		if b, err := os.ReadFile(filepath.Join(config.E.TemplateDir, fileName)); err == nil {
+			return string(b), nil
+		}
+	}
+	b, err := templateFS.ReadFile("templates/" + fileName)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
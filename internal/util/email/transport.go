@@ -0,0 +1,263 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+)
+
+type (
+	// Transport delivers an already built message
+	Transport interface {
+		Send(ctx context.Context, from string, to []string, msg []byte) error
+	}
+
+	SMTPTransport struct {
+		Host     string
+		Port     int
+		Security string // starttls (when offered), require (starttls or fail), tls or none
+		Auth     string // plain, login, cram-md5 or none
+		User     string
+		Pwd      string
+	}
+
+	// FileTransport writes each message to Dir as a .eml file, or as a maildir (tmp/new/cur) when Maildir is set
+	FileTransport struct {
+		Dir     string
+		Maildir bool
+	}
+
+	// MemoryTransport keeps the messages, see Capture
+	MemoryTransport struct {
+		mu       sync.Mutex
+		messages []SentMessage
+	}
+
+	SentMessage struct {
+		From    string    `json:"from"`
+		To      []string  `json:"to"`
+		Subject string    `json:"subject"`
+		SentAt  time.Time `json:"sent_at"`
+		Raw     string    `json:"raw"`
+	}
+
+	loginAuth struct {
+		user, pwd string
+	}
+)
+
+const (
+	TransportSMTP    = "smtp"
+	TransportFile    = "file"
+	TransportMaildir = "maildir"
+	TransportMemory  = "memory"
+
+	SecurityStartTLS = "starttls" // used when the server offers it, like smtp.SendMail
+	SecurityRequire  = "require"  // starttls, the message is not sent in the clear
+	SecurityTLS      = "tls"
+	SecurityNone     = "none"
+
+	AuthPlain   = "plain"
+	AuthLogin   = "login"
+	AuthCRAMMD5 = "cram-md5"
+	AuthNone    = "none"
+
+	captureLimit = 500 // the oldest are dropped past this
+)
+
+// Capture is what the memory transport has sent, shared so the admin endpoint and tests can read it
+var Capture = &MemoryTransport{}
+
+// TransportInit picks the transport from TITHE_DECLARE_EMAIL_TRANSPORT
+func TransportInit() Transport {
+	transport := config.E.Transport
+	if transport == "" {
+		transport = TransportMemory
+		if config.E.Host != "" {
+			transport = TransportSMTP
+		}
+	}
+	switch transport {
+	case TransportSMTP:
+		user := config.E.User
+		if user == "" {
+			user = envelopeAddress(config.E.From)
+		}
+		return &SMTPTransport{Host: config.E.Host, Port: config.E.GetEmailPort(), Security: config.E.Security, Auth: config.E.Auth, User: user, Pwd: config.E.Pwd}
+	case TransportFile:
+		return &FileTransport{Dir: config.E.Dir}
+	case TransportMaildir:
+		return &FileTransport{Dir: config.E.Dir, Maildir: true}
+	default:
+		return Capture
+	}
+}
+
+func (s *SMTPTransport) Send(ctx context.Context, from string, to []string, msg []byte) error {
+	addr := net.JoinHostPort(s.Host, fmt.Sprint(s.Port))
+	tlsConfig := &tls.Config{ServerName: s.Host}
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	var conn net.Conn
+	var err error
+	if s.Security == SecurityTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: tlsConfig}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, s.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+	if s.Security == SecurityStartTLS || s.Security == SecurityRequire {
+		ok, _ := c.Extension("STARTTLS")
+		if !ok && s.Security == SecurityRequire {
+			return errors.New("smtp: server does not support STARTTLS")
+		}
+		if ok {
+			if err := c.StartTLS(tlsConfig); err != nil {
+				return err
+			}
+		}
+	}
+	if auth := s.auth(); auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("smtp: server does not support AUTH")
+		}
+		if err := c.Auth(auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(envelopeAddress(from)); err != nil {
+		return err
+	}
+	for _, rcpt := range to {
+		if err := c.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func (s *SMTPTransport) auth() smtp.Auth {
+	switch s.Auth {
+	case AuthNone:
+		return nil
+	case AuthLogin:
+		return &loginAuth{user: s.User, pwd: s.Pwd}
+	case AuthCRAMMD5:
+		return smtp.CRAMMD5Auth(s.User, s.Pwd)
+	default:
+		return smtp.PlainAuth("", s.User, s.Pwd, s.Host)
+	}
+}
+
+// LOGIN isn't in net/smtp but some servers (e.g.: Office 365) only offer it
+func (a *loginAuth) Start(server *smtp.ServerInfo) (string, []byte, error) {
+	if !server.TLS {
+		return "", nil, errors.New("smtp: unencrypted connection")
+	}
+	return "LOGIN", nil, nil
+}
+
+func (a *loginAuth) Next(fromServer []byte, more bool) ([]byte, error) {
+	if !more {
+		return nil, nil
+	}
+	switch string(bytes.ToLower(bytes.TrimSpace(fromServer))) {
+	case "username:":
+		return []byte(a.user), nil
+	case "password:":
+		return []byte(a.pwd), nil
+	}
+	return nil, fmt.Errorf("smtp: unexpected LOGIN challenge: %s", fromServer)
+}
+
+func (f *FileTransport) Send(ctx context.Context, from string, to []string, msg []byte) error {
+	b := make([]byte, 8)
+	rand.Read(b)
+	name := fmt.Sprintf("%d.%s", time.Now().UnixNano(), hex.EncodeToString(b))
+	if !f.Maildir {
+		if err := os.MkdirAll(f.Dir, 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(filepath.Join(f.Dir, name+".eml"), msg, 0644)
+	}
+	for _, sub := range []string{"tmp", "new", "cur"} {
+		if err := os.MkdirAll(filepath.Join(f.Dir, sub), 0755); err != nil {
+			return err
+		}
+	}
+	// written to tmp then moved to new, so a reader never sees half a message
+	tmp := filepath.Join(f.Dir, "tmp", name)
+	if err := os.WriteFile(tmp, msg, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(f.Dir, "new", name))
+}
+
+func (mt *MemoryTransport) Send(ctx context.Context, from string, to []string, msg []byte) error {
+	sent := SentMessage{From: from, To: append([]string{}, to...), SentAt: time.Now().UTC(), Raw: string(msg)}
+	if m, err := mail.ReadMessage(bytes.NewReader(msg)); err == nil {
+		sent.Subject, _ = new(mime.WordDecoder).DecodeHeader(m.Header.Get("Subject"))
+	}
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+	mt.messages = append(mt.messages, sent)
+	if len(mt.messages) > captureLimit {
+		mt.messages = mt.messages[len(mt.messages)-captureLimit:]
+	}
+	return nil
+}
+
+// Messages is a copy of what has been sent, oldest first
+func (mt *MemoryTransport) Messages() []SentMessage {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+	return append([]SentMessage{}, mt.messages...)
+}
+
+func (mt *MemoryTransport) Reset() {
+	mt.mu.Lock()
+	defer mt.mu.Unlock()
+	mt.messages = nil
+}
+
+// envelopeAddress is the bare address of e.g.: "Ward Clerk <clerk@example.com>"
+func envelopeAddress(from string) string {
+	if addr, err := mail.ParseAddress(from); err == nil {
+		return addr.Address
+	}
+	return from
+}
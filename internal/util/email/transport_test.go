@@ -0,0 +1,137 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileTransport_Send(t *testing.T) {
+	ctx := context.TODO()
+	tests := []struct {
+		name    string
+		maildir bool
+		wantDir string
+	}{
+		{"file", false, ""},
+		{"maildir", true, "new"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			f := &FileTransport{Dir: dir, Maildir: tt.maildir}
+			assert.Nil(t, f.Send(ctx, "clerk@example.com", []string{"smith@example.com"}, []byte("Subject: hi\r\n\r\nbody")))
+			files, _ := os.ReadDir(filepath.Join(dir, tt.wantDir))
+			sent := []string{}
+			for _, file := range files {
+				if !file.IsDir() {
+					sent = append(sent, file.Name())
+				}
+			}
+			assert.Equal(t, 1, len(sent), "%s => expected one message", tt.name)
+			b, _ := os.ReadFile(filepath.Join(dir, tt.wantDir, sent[0]))
+			assert.Equal(t, "Subject: hi\r\n\r\nbody", string(b))
+			if tt.maildir {
+				tmp, _ := os.ReadDir(filepath.Join(dir, "tmp"))
+				assert.Equal(t, 0, len(tmp), "%s => nothing left in tmp", tt.name)
+			} else {
+				assert.True(t, strings.HasSuffix(sent[0], ".eml"))
+			}
+		})
+	}
+}
+
+func TestEmail_MemoryTransport(t *testing.T) {
+	ctx := context.TODO()
+	capture := &MemoryTransport{}
+	e := Email{transport: capture}
+
+	assert.Nil(t, e.SendManageLink(ctx, "smith@example.com", "Sunday, December 7, 2025, 09:00 AM - 09:20 AM", "abc", []byte("BEGIN:VCALENDAR")))
+	assert.Nil(t, e.SendConfirmation(ctx, []string{"bishop@example.com", "clerk@example.com"}, "A tithing declaration has been scheduled"))
+
+	sent := capture.Messages()
+	assert.Equal(t, 2, len(sent))
+	assert.Equal(t, []string{"smith@example.com"}, sent[0].To)
+	assert.Equal(t, "Tithing Declaration Confirmed", sent[0].Subject)
+	assert.Contains(t, sent[0].Raw, "invite.ics")
+	assert.Equal(t, []string{"bishop@example.com", "clerk@example.com"}, sent[1].To)
+	assert.Equal(t, "New Tithing Declaration Scheduled", sent[1].Subject)
+
+	capture.Reset()
+	assert.Equal(t, 0, len(capture.Messages()))
+}
+
+func TestSMTPTransport_Send(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer ln.Close()
+	received := make(chan []string, 1)
+	go fakeSMTPServer(ln, received)
+
+	addr := ln.Addr().(*net.TCPAddr)
+	s := &SMTPTransport{Host: "127.0.0.1", Port: addr.Port, Security: SecurityNone, Auth: AuthNone}
+	err = s.Send(context.TODO(), "Ward Clerk <clerk@example.com>", []string{"smith@example.com"}, []byte("Subject: hi\r\n\r\nbody\r\n"))
+	assert.Nil(t, err)
+	commands := <-received
+	assert.Contains(t, commands, "MAIL FROM:<clerk@example.com>")
+	assert.Contains(t, commands, "RCPT TO:<smith@example.com>")
+	assert.Contains(t, commands, "body")
+
+	// STARTTLS is used when offered, without it the message still goes out
+	go fakeSMTPServer(ln, received)
+	s.Security = SecurityStartTLS
+	err = s.Send(context.TODO(), "clerk@example.com", []string{"smith@example.com"}, []byte("Subject: hi\r\n\r\nbody\r\n"))
+	assert.Nil(t, err)
+	<-received
+
+	// unless it is required, then the message is not sent in the clear
+	go fakeSMTPServer(ln, received)
+	s.Security = SecurityRequire
+	err = s.Send(context.TODO(), "clerk@example.com", []string{"smith@example.com"}, []byte("Subject: hi\r\n\r\nbody\r\n"))
+	assert.NotNil(t, err)
+}
+
+// fakeSMTPServer takes one connection, accepting everything, and hands back the lines it was sent
+func fakeSMTPServer(ln net.Listener, received chan<- []string) {
+	conn, err := ln.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	write := func(s string) { conn.Write([]byte(s + "\r\n")) }
+	lines := []string{}
+	write("220 localhost ESMTP")
+	inData := false
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		lines = append(lines, line)
+		switch {
+		case inData && line == ".":
+			inData = false
+			write("250 OK")
+		case inData:
+		case strings.HasPrefix(line, "EHLO"):
+			write("250 localhost")
+		case line == "DATA":
+			inData = true
+			write("354 go ahead")
+		case line == "QUIT":
+			write("221 bye")
+			received <- lines
+			return
+		default:
+			write("250 OK")
+		}
+	}
+}
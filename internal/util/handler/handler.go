@@ -20,10 +20,11 @@ type (
 	}
 
 	Error struct {
-		Id     string `json:"Id,omitempty"`
-		Title  string `json:"Title,omitempty"`
-		Detail string `json:"Detail,omitempty"`
-		Status string `json:"Status,omitempty"`
+		Id     string          `json:"Id,omitempty"`
+		Title  string          `json:"Title,omitempty"`
+		Detail string          `json:"Detail,omitempty"`
+		Status string          `json:"Status,omitempty"`
+		Fields []ae.FieldError `json:"Fields,omitempty"` // what is wrong with each input, shown beside it
 	}
 
 	Meta struct {
@@ -72,7 +73,7 @@ func FormatResponse(c echo.Context, statusCode int, payload any, totalCount *int
 
 func FormatResponseWithError(c echo.Context, apiError ae.ApiError) error {
 	LogError(c, &apiError)
-	err := &Error{Id: apiError.ApiErrorCode, Title: apiError.Title, Detail: apiError.Detail, Status: strconv.Itoa(apiError.StatusCode)}
+	err := &Error{Id: apiError.ApiErrorCode, Title: apiError.Title, Detail: apiError.Detail, Status: strconv.Itoa(apiError.StatusCode), Fields: apiError.Fields}
 	output := Output{
 		Payload: nil,
 		Error:   err,
@@ -0,0 +1,110 @@
+package ics
+
+import (
+	"bytes"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// minimal RFC 5545 writer, only what is needed to publish appointments
+
+type (
+	Calendar struct {
+		ProdId string
+		Name   string // X-WR-CALNAME, shown by most calendar apps when subscribing
+		Method string // defaults to PUBLISH
+		Events []Event
+	}
+
+	Event struct {
+		Uid         string
+		Stamp       time.Time // defaults to now
+		Start       time.Time
+		End         time.Time
+		Summary     string
+		Description string
+		Location    string
+		Url         string
+	}
+)
+
+const (
+	ContentType   = "text/calendar; charset=utf-8"
+	layoutUTC     = "20060102T150405Z"
+	maxLineOctets = 75
+)
+
+func (c Calendar) Bytes() []byte {
+	method := c.Method
+	if method == "" {
+		method = "PUBLISH"
+	}
+	buf := &bytes.Buffer{}
+	writeLine(buf, "BEGIN", "VCALENDAR")
+	writeLine(buf, "VERSION", "2.0")
+	writeLine(buf, "PRODID", c.ProdId)
+	writeLine(buf, "CALSCALE", "GREGORIAN")
+	writeLine(buf, "METHOD", method)
+	if c.Name != "" {
+		writeLine(buf, "X-WR-CALNAME", escapeText(c.Name))
+	}
+	for _, e := range c.Events {
+		e.write(buf)
+	}
+	writeLine(buf, "END", "VCALENDAR")
+	return buf.Bytes()
+}
+
+func (e Event) write(buf *bytes.Buffer) {
+	stamp := e.Stamp
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+	writeLine(buf, "BEGIN", "VEVENT")
+	writeLine(buf, "UID", e.Uid)
+	writeLine(buf, "DTSTAMP", formatTime(stamp))
+	writeLine(buf, "DTSTART", formatTime(e.Start))
+	if !e.End.IsZero() {
+		writeLine(buf, "DTEND", formatTime(e.End))
+	}
+	writeLine(buf, "SUMMARY", escapeText(e.Summary))
+	if e.Description != "" {
+		writeLine(buf, "DESCRIPTION", escapeText(e.Description))
+	}
+	if e.Location != "" {
+		writeLine(buf, "LOCATION", escapeText(e.Location))
+	}
+	if e.Url != "" {
+		writeLine(buf, "URL", e.Url)
+	}
+	writeLine(buf, "END", "VEVENT")
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(layoutUTC)
+}
+
+// escapeText escapes a TEXT value (RFC 5545 3.3.11)
+func escapeText(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+	return r.Replace(s)
+}
+
+// writeLine writes the content line folded at 75 octets (RFC 5545 3.1) without splitting a utf-8 character
+func writeLine(buf *bytes.Buffer, name, value string) {
+	line := name + ":" + value
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1 // the leading space counts against the next line
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,71 @@
+package ics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalendarBytes(t *testing.T) {
+	start := time.Date(2025, 12, 7, 16, 0, 0, 0, time.UTC)
+	cal := Calendar{
+		ProdId: "-//test//EN",
+		Name:   "Declarations",
+		Events: []Event{{Uid: "1@test", Stamp: start, Start: start, End: start.Add(15 * time.Minute), Summary: "Smith, Family; 2"}},
+	}
+	out := string(cal.Bytes())
+	assert.True(t, strings.HasPrefix(out, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"), "Calendar.Bytes() => unexpected header: %s", out)
+	assert.True(t, strings.HasSuffix(out, "END:VCALENDAR\r\n"), "Calendar.Bytes() => unexpected footer: %s", out)
+	assert.Contains(t, out, "METHOD:PUBLISH\r\n")
+	assert.Contains(t, out, "DTSTART:20251207T160000Z\r\n")
+	assert.Contains(t, out, "DTEND:20251207T161500Z\r\n")
+	assert.Contains(t, out, `SUMMARY:Smith\, Family\; 2`+"\r\n")
+}
+
+func TestEscapeText(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "Tithing Declaration", "Tithing Declaration"},
+		{"separators", `a,b;c\d`, `a\,b\;c\\d`},
+		{"newlines", "line one\r\nline two\nthree", `line one\nline two\nthree`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, escapeText(tt.in), "escapeText().%s => unexpected result", tt.name)
+		})
+	}
+}
+
+func TestWriteLineFold(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"short", "short value"},
+		{"long ascii", strings.Repeat("abcdefghij", 20)},
+		{"long multibyte", strings.Repeat("déclaration ", 20)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			writeLine(buf, "DESCRIPTION", tt.value)
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+			unfolded := ""
+			for i, line := range lines {
+				assert.LessOrEqual(t, len(line), maxLineOctets, "writeLine().%s => line %d too long", tt.name, i)
+				if i > 0 {
+					assert.True(t, strings.HasPrefix(line, " "), "writeLine().%s => continuation missing space", tt.name)
+					line = line[1:]
+				}
+				unfolded += line
+			}
+			assert.Equal(t, "DESCRIPTION:"+tt.value, unfolded, "writeLine().%s => unfolded mismatch", tt.name)
+		})
+	}
+}
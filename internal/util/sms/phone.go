@@ -0,0 +1,47 @@
+package sms
+
+import (
+	"errors"
+	"strings"
+)
+
+// NormalizePhone formats the number as E.164 (e.g.: +18015550123)
+// a number without a leading + (or 00) is taken as national and given defaultCountry (e.g.: "1"), unless it already starts with it
+func NormalizePhone(phone, defaultCountry string) (string, error) {
+	phone = strings.TrimSpace(phone)
+	if ext := strings.IndexAny(strings.ToLower(phone), "ex#"); ext > -1 {
+		// extensions can't be texted, drop them
+		phone = strings.TrimSpace(phone[:ext])
+	}
+	international := strings.HasPrefix(phone, "+") || strings.HasPrefix(phone, "00")
+	digits := &strings.Builder{}
+	for _, r := range phone {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case strings.ContainsRune(" -.()/+", r):
+		default:
+			return "", errors.New("contains characters that are not part of a phone number")
+		}
+	}
+	number := digits.String()
+	if international {
+		number = strings.TrimPrefix(number, "00")
+	} else {
+		number = strings.TrimPrefix(number, "0") // national trunk prefix, e.g.: 020 7946 0018
+		if defaultCountry == "1" && len(number) == 11 && strings.HasPrefix(number, "1") {
+			number = number[1:]
+		}
+		number = defaultCountry + number
+	}
+	if defaultCountry == "1" && !international && len(number) != 11 {
+		return "", errors.New("must be 10 digits")
+	}
+	if len(number) < 8 || len(number) > 15 {
+		return "", errors.New("must be between 8 and 15 digits including the country code")
+	}
+	if number[0] == '0' {
+		return "", errors.New("country code cannot start with 0")
+	}
+	return "+" + number, nil
+}
@@ -0,0 +1,36 @@
+package sms
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	tests := []struct {
+		name           string
+		phone          string
+		defaultCountry string
+		want           string
+		wantErr        bool
+	}{
+		{"national formatted", "(801) 555-0123", "1", "+18015550123", false},
+		{"national dots", "801.555.0123", "1", "+18015550123", false},
+		{"national with 1", "1-801-555-0123", "1", "+18015550123", false},
+		{"already E.164", "+18015550123", "1", "+18015550123", false},
+		{"international 00", "0044 20 7946 0018", "1", "+442079460018", false},
+		{"international +", "+44 20 7946 0018", "1", "+442079460018", false},
+		{"national trunk prefix", "020 7946 0018", "44", "+442079460018", false},
+		{"extension dropped", "801-555-0123 ext 12", "1", "+18015550123", false},
+		{"too short", "555-0123", "1", "", true},
+		{"too long", "+1234567890123456", "1", "", true},
+		{"letters", "801-CALL-NOW", "1", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizePhone(tt.phone, tt.defaultCountry)
+			assert.Equal(t, tt.wantErr, err != nil, "NormalizePhone().%s => expected error: got: %s", tt.name, err)
+			assert.Equal(t, tt.want, got, "NormalizePhone().%s", tt.name)
+		})
+	}
+}
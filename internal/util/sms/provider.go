@@ -0,0 +1,76 @@
+package sms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+)
+
+type (
+	// Provider delivers a single text message
+	Provider interface {
+		Send(ctx context.Context, to, body string) error
+	}
+
+	// WebhookProvider posts {"from", "to", "body"} as json to Url, the gateway (e.g.: a small Twilio relay) does the rest
+	WebhookProvider struct {
+		Url    string
+		Token  string // sent as a Bearer token, if set
+		From   string
+		Client *http.Client
+	}
+
+	// LogProvider only logs the message, for dev or when no provider is set up
+	LogProvider struct{}
+
+	webhookMessage struct {
+		From string `json:"from,omitempty"`
+		To   string `json:"to"`
+		Body string `json:"body"`
+	}
+)
+
+const (
+	ProviderWebhook = "webhook"
+	ProviderLog     = "log"
+)
+
+func (w *WebhookProvider) Send(ctx context.Context, to, body string) error {
+	payload, err := json.Marshal(webhookMessage{From: w.From, To: to, Body: body})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.Url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.Token)
+	}
+	client := w.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("sms webhook: status %d: %s", resp.StatusCode, bytes.TrimSpace(detail))
+	}
+	return nil
+}
+
+func (l *LogProvider) Send(ctx context.Context, to, body string) error {
+	logging.Default.Printf("sms to: %s: %s", to, body)
+	return nil
+}
@@ -0,0 +1,30 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookProvider_Send(t *testing.T) {
+	received := webhookMessage{}
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	w := &WebhookProvider{Url: server.URL, Token: "secret", From: "+18015550100"}
+	assert.Nil(t, w.Send(context.TODO(), "+18015550123", "hello"))
+	assert.Equal(t, webhookMessage{From: "+18015550100", To: "+18015550123", Body: "hello"}, received)
+
+	status = http.StatusBadGateway
+	assert.NotNil(t, w.Send(context.TODO(), "+18015550123", "hello"), "a non 2xx is an error so the outbox retries it")
+}
@@ -0,0 +1,52 @@
+package sms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/blackflagsoftware/tithe-declare/config"
+	"github.com/blackflagsoftware/tithe-declare/internal/middleware/logging"
+)
+
+//go:generate mockgen -source=sms.go -destination=mock.go -package=sms
+type (
+	// Notifier is the text message counterpart of email.Emailer, only used for those who opted in
+	Notifier interface {
+		SendConfirmation(context.Context, string, string) error
+		SendReminder(context.Context, string, string) error
+	}
+
+	SMS struct {
+		provider Provider
+	}
+)
+
+func SMSInit() Notifier {
+	return &SMS{provider: ProviderInit()}
+}
+
+// SendConfirmation texts the booked appointment, to is E.164
+func (s SMS) SendConfirmation(ctx context.Context, to, appointment string) error {
+	return s.send(ctx, to, fmt.Sprintf("Your tithing declaration is scheduled for %s.", appointment))
+}
+
+// SendReminder texts a reminder of the appointment, to is E.164
+func (s SMS) SendReminder(ctx context.Context, to, appointment string) error {
+	return s.send(ctx, to, fmt.Sprintf("Reminder: your tithing declaration is %s.", appointment))
+}
+
+func (s SMS) send(ctx context.Context, to, body string) error {
+	if err := s.provider.Send(ctx, to, body); err != nil {
+		logging.Default.Println("unable to send sms:", err)
+		return err
+	}
+	return nil
+}
+
+// ProviderInit picks the provider from TITHE_DECLARE_SMS_PROVIDER
+func ProviderInit() Provider {
+	if config.S.Provider == ProviderWebhook {
+		return &WebhookProvider{Url: config.S.WebhookUrl, Token: config.S.WebhookToken, From: config.S.From}
+	}
+	return &LogProvider{}
+}
@@ -0,0 +1,89 @@
+package validate
+
+import (
+	"net/mail"
+	"strconv"
+	"strings"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	"github.com/blackflagsoftware/tithe-declare/internal/util/sms"
+	"gopkg.in/guregu/null.v3"
+)
+
+type (
+	// Validator collects what is wrong with each input so they can all be returned at once
+	// the field names are the json names the front end sent
+	Validator struct {
+		Fields []ae.FieldError
+	}
+)
+
+func (v *Validator) Add(field, message string) {
+	for _, f := range v.Fields {
+		if f.Field == field {
+			return // first problem only
+		}
+	}
+	v.Fields = append(v.Fields, ae.FieldError{Field: field, Message: message})
+}
+
+// Trim trims the value, one that is left empty becomes null
+func Trim(value *null.String) {
+	if !value.Valid {
+		return
+	}
+	value.String = strings.TrimSpace(value.String)
+	if value.String == "" {
+		*value = null.String{}
+	}
+}
+
+// Required trims the value, it can't be left empty
+func (v *Validator) Required(field string, value *null.String) {
+	Trim(value)
+	if !value.Valid {
+		v.Add(field, "is required")
+	}
+}
+
+func (v *Validator) MaxLength(field string, value null.String, maxLen int) {
+	if value.Valid && len(value.String) > maxLen {
+		v.Add(field, "must be at most "+strconv.Itoa(maxLen)+" characters")
+	}
+}
+
+// Email trims and checks the value as an RFC 5322 address, it is set to the bare address (e.g.: "Smith <a@b.com>" => "a@b.com")
+func (v *Validator) Email(field string, value *null.String) {
+	Trim(value)
+	if !value.Valid {
+		return
+	}
+	addr, err := mail.ParseAddress(value.String)
+	if err != nil {
+		v.Add(field, "is not a valid email address")
+		return
+	}
+	value.String = addr.Address
+}
+
+// Phone trims and normalizes the value to E.164
+func (v *Validator) Phone(field string, value *null.String, defaultCountry string) {
+	Trim(value)
+	if !value.Valid {
+		return
+	}
+	phone, err := sms.NormalizePhone(value.String, defaultCountry)
+	if err != nil {
+		v.Add(field, "is not a valid phone number, "+err.Error())
+		return
+	}
+	value.String = phone
+}
+
+// Err is nil when every field passed, otherwise an ae.ValidationError listing them
+func (v *Validator) Err() error {
+	if len(v.Fields) == 0 {
+		return nil
+	}
+	return ae.ValidationError(v.Fields)
+}
@@ -0,0 +1,45 @@
+package validate
+
+import (
+	"testing"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/guregu/null.v3"
+)
+
+func TestValidator(t *testing.T) {
+	name := null.StringFrom("  Smith Family ")
+	email := null.StringFrom(" Smith <smith@example.com> ")
+	phone := null.StringFrom("(801) 555-0123")
+	v := &Validator{}
+	v.Required("name", &name)
+	v.Email("email", &email)
+	v.Phone("phone", &phone, "1")
+	assert.Nil(t, v.Err())
+	assert.Equal(t, "Smith Family", name.String)
+	assert.Equal(t, "smith@example.com", email.String)
+	assert.Equal(t, "+18015550123", phone.String)
+
+	name = null.StringFrom("   ")
+	email = null.StringFrom("smith.example.com")
+	phone = null.StringFrom("555")
+	empty := null.StringFrom("")
+	v = &Validator{}
+	v.Required("name", &name)
+	v.Email("email", &email)
+	v.Email("email", &email) // only the first problem per field
+	v.Phone("phone", &phone, "1")
+	v.Email("alt_email", &empty)
+	v.MaxLength("email", null.StringFrom("smith@example.com"), 5)
+	err := v.Err()
+	assert.NotNil(t, err)
+	apiErr := err.(ae.ApiError)
+	assert.Equal(t, 400, apiErr.StatusCode)
+	assert.Equal(t, []ae.FieldError{
+		{Field: "name", Message: "is required"},
+		{Field: "email", Message: "is not a valid email address"},
+		{Field: "phone", Message: "is not a valid phone number, must be 10 digits"},
+	}, apiErr.Fields)
+	assert.False(t, empty.Valid, "an empty optional value becomes null")
+}
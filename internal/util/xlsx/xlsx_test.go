@@ -0,0 +1,66 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	x, err := NewWriter(buf, "Bookings: 2025/12")
+	assert.Nil(t, err)
+	assert.Nil(t, x.WriteHeader([]string{"name", "email"}))
+	assert.Nil(t, x.WriteRow([]string{"Smith & Sons <1>", "smith@example.com\x01"}))
+	assert.Nil(t, x.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	assert.Nil(t, err)
+	parts := map[string]string{}
+	for _, f := range zr.File {
+		rc, _ := f.Open()
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[f.Name] = string(b)
+		// every part must be well formed
+		dec := xml.NewDecoder(bytes.NewReader(b))
+		for {
+			_, errTok := dec.Token()
+			if errTok == io.EOF {
+				break
+			}
+			assert.Nil(t, errTok, "Writer => %s is not well formed", f.Name)
+			if errTok != nil {
+				break
+			}
+		}
+	}
+	assert.Contains(t, parts, "[Content_Types].xml")
+	assert.Contains(t, parts["xl/workbook.xml"], `name="Bookings 202512"`)
+	sheet := parts["xl/worksheets/sheet1.xml"]
+	assert.Contains(t, sheet, `<c t="inlineStr" s="1"><is><t xml:space="preserve">name</t></is></c>`)
+	assert.Contains(t, sheet, "Smith &amp; Sons &lt;1&gt;")
+	assert.Contains(t, sheet, ">smith@example.com<")
+}
+
+func TestSheetLabel(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "Bookings", "Bookings"},
+		{"not allowed", "a/b:c", "abc"},
+		{"empty", "", "Sheet1"},
+		{"too long", "Tithing Declarations for December", "Tithing Declarations for Decemb"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sheetLabel(tt.in), "sheetLabel().%s => unexpected result", tt.name)
+		})
+	}
+}
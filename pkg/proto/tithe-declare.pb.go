@@ -902,6 +902,8 @@ type TdDate struct {
 	Name          string                 `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
 	Phone         string                 `protobuf:"bytes,6,opt,name=Phone,proto3" json:"Phone,omitempty"`
 	Email         string                 `protobuf:"bytes,7,opt,name=Email,proto3" json:"Email,omitempty"`
+	EndValue      string                 `protobuf:"bytes,8,opt,name=EndValue,proto3" json:"EndValue,omitempty"`
+	InterviewerId int64                  `protobuf:"varint,9,opt,name=InterviewerId,proto3" json:"InterviewerId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -985,29 +987,1234 @@ func (x *TdDate) GetEmail() string {
 	return ""
 }
 
+func (x *TdDate) GetEndValue() string {
+	if x != nil {
+		return x.EndValue
+	}
+	return ""
+}
+
+func (x *TdDate) GetInterviewerId() int64 {
+	if x != nil {
+		return x.InterviewerId
+	}
+	return 0
+}
+
 type TdDateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TdDate        *TdDate                `protobuf:"bytes,1,opt,name=TdDate,proto3" json:"TdDate,omitempty"`
-	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	TdDate        *TdDate                `protobuf:"bytes,1,opt,name=TdDate,proto3" json:"TdDate,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TdDateResponse) Reset() {
+	*x = TdDateResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TdDateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TdDateResponse) ProtoMessage() {}
+
+func (x *TdDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TdDateResponse.ProtoReflect.Descriptor instead.
+func (*TdDateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TdDateResponse) GetTdDate() *TdDate {
+	if x != nil {
+		return x.TdDate
+	}
+	return nil
+}
+
+func (x *TdDateResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type TdDateRepeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TdDate        []*TdDate              `protobuf:"bytes,1,rep,name=TdDate,proto3" json:"TdDate,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TdDateRepeatResponse) Reset() {
+	*x = TdDateRepeatResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TdDateRepeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TdDateRepeatResponse) ProtoMessage() {}
+
+func (x *TdDateRepeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TdDateRepeatResponse.ProtoReflect.Descriptor instead.
+func (*TdDateRepeatResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TdDateRepeatResponse) GetTdDate() []*TdDate {
+	if x != nil {
+		return x.TdDate
+	}
+	return nil
+}
+
+func (x *TdDateRepeatResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type TdDateIDIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TdDateIDIn) Reset() {
+	*x = TdDateIDIn{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TdDateIDIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TdDateIDIn) ProtoMessage() {}
+
+func (x *TdDateIDIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TdDateIDIn.ProtoReflect.Descriptor instead.
+func (*TdDateIDIn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TdDateIDIn) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ExpandTemplateIn struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleTemplateId int64                  `protobuf:"varint,1,opt,name=ScheduleTemplateId,proto3" json:"ScheduleTemplateId,omitempty"`
+	InterviewerIds     []int64                `protobuf:"varint,2,rep,packed,name=InterviewerIds,proto3" json:"InterviewerIds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExpandTemplateIn) Reset() {
+	*x = ExpandTemplateIn{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandTemplateIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandTemplateIn) ProtoMessage() {}
+
+func (x *ExpandTemplateIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandTemplateIn.ProtoReflect.Descriptor instead.
+func (*ExpandTemplateIn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExpandTemplateIn) GetScheduleTemplateId() int64 {
+	if x != nil {
+		return x.ScheduleTemplateId
+	}
+	return 0
+}
+
+func (x *ExpandTemplateIn) GetInterviewerIds() []int64 {
+	if x != nil {
+		return x.InterviewerIds
+	}
+	return nil
+}
+
+type ExpandTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       int64                  `protobuf:"varint,1,opt,name=Created,proto3" json:"Created,omitempty"`
+	Skipped       int64                  `protobuf:"varint,2,opt,name=Skipped,proto3" json:"Skipped,omitempty"`
+	Result        *Result                `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	BlackedOut    int64                  `protobuf:"varint,4,opt,name=BlackedOut,proto3" json:"BlackedOut,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandTemplateResponse) Reset() {
+	*x = ExpandTemplateResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandTemplateResponse) ProtoMessage() {}
+
+func (x *ExpandTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandTemplateResponse.ProtoReflect.Descriptor instead.
+func (*ExpandTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExpandTemplateResponse) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ExpandTemplateResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ExpandTemplateResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ExpandTemplateResponse) GetBlackedOut() int64 {
+	if x != nil {
+		return x.BlackedOut
+	}
+	return 0
+}
+
+type TdDateStatsIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=From,proto3" json:"From,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=To,proto3" json:"To,omitempty"`
+	SeasonId      int64                  `protobuf:"varint,3,opt,name=SeasonId,proto3" json:"SeasonId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TdDateStatsIn) Reset() {
+	*x = TdDateStatsIn{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TdDateStatsIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TdDateStatsIn) ProtoMessage() {}
+
+func (x *TdDateStatsIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TdDateStatsIn.ProtoReflect.Descriptor instead.
+func (*TdDateStatsIn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TdDateStatsIn) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *TdDateStatsIn) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *TdDateStatsIn) GetSeasonId() int64 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+type SlotCounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slots         int64                  `protobuf:"varint,1,opt,name=Slots,proto3" json:"Slots,omitempty"`
+	Held          int64                  `protobuf:"varint,2,opt,name=Held,proto3" json:"Held,omitempty"`
+	Confirmed     int64                  `protobuf:"varint,3,opt,name=Confirmed,proto3" json:"Confirmed,omitempty"`
+	Open          int64                  `protobuf:"varint,4,opt,name=Open,proto3" json:"Open,omitempty"`
+	FillRate      float64                `protobuf:"fixed64,5,opt,name=FillRate,proto3" json:"FillRate,omitempty"`
+	Closed        int64                  `protobuf:"varint,6,opt,name=Closed,proto3" json:"Closed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SlotCounts) Reset() {
+	*x = SlotCounts{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SlotCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlotCounts) ProtoMessage() {}
+
+func (x *SlotCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlotCounts.ProtoReflect.Descriptor instead.
+func (*SlotCounts) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SlotCounts) GetSlots() int64 {
+	if x != nil {
+		return x.Slots
+	}
+	return 0
+}
+
+func (x *SlotCounts) GetHeld() int64 {
+	if x != nil {
+		return x.Held
+	}
+	return 0
+}
+
+func (x *SlotCounts) GetConfirmed() int64 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return 0
+}
+
+func (x *SlotCounts) GetOpen() int64 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *SlotCounts) GetFillRate() float64 {
+	if x != nil {
+		return x.FillRate
+	}
+	return 0
+}
+
+func (x *SlotCounts) GetClosed() int64 {
+	if x != nil {
+		return x.Closed
+	}
+	return 0
+}
+
+type DayStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=Date,proto3" json:"Date,omitempty"`
+	Counts        *SlotCounts            `protobuf:"bytes,2,opt,name=Counts,proto3" json:"Counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DayStats) Reset() {
+	*x = DayStats{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DayStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DayStats) ProtoMessage() {}
+
+func (x *DayStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DayStats.ProtoReflect.Descriptor instead.
+func (*DayStats) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DayStats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DayStats) GetCounts() *SlotCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type SeasonStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      int64                  `protobuf:"varint,1,opt,name=SeasonId,proto3" json:"SeasonId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Counts        *SlotCounts            `protobuf:"bytes,3,opt,name=Counts,proto3" json:"Counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeasonStats) Reset() {
+	*x = SeasonStats{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeasonStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonStats) ProtoMessage() {}
+
+func (x *SeasonStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonStats.ProtoReflect.Descriptor instead.
+func (*SeasonStats) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SeasonStats) GetSeasonId() int64 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *SeasonStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SeasonStats) GetCounts() *SlotCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type WeekdayStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       string                 `protobuf:"bytes,1,opt,name=Weekday,proto3" json:"Weekday,omitempty"`
+	Counts        *SlotCounts            `protobuf:"bytes,2,opt,name=Counts,proto3" json:"Counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeekdayStats) Reset() {
+	*x = WeekdayStats{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeekdayStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekdayStats) ProtoMessage() {}
+
+func (x *WeekdayStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekdayStats.ProtoReflect.Descriptor instead.
+func (*WeekdayStats) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WeekdayStats) GetWeekday() string {
+	if x != nil {
+		return x.Weekday
+	}
+	return ""
+}
+
+func (x *WeekdayStats) GetCounts() *SlotCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type HourStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hour          int64                  `protobuf:"varint,1,opt,name=Hour,proto3" json:"Hour,omitempty"`
+	Counts        *SlotCounts            `protobuf:"bytes,2,opt,name=Counts,proto3" json:"Counts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HourStats) Reset() {
+	*x = HourStats{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HourStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HourStats) ProtoMessage() {}
+
+func (x *HourStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HourStats.ProtoReflect.Descriptor instead.
+func (*HourStats) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HourStats) GetHour() int64 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *HourStats) GetCounts() *SlotCounts {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type LeadTime struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bookings      int64                  `protobuf:"varint,1,opt,name=Bookings,proto3" json:"Bookings,omitempty"`
+	AverageDays   float64                `protobuf:"fixed64,2,opt,name=AverageDays,proto3" json:"AverageDays,omitempty"`
+	MinDays       float64                `protobuf:"fixed64,3,opt,name=MinDays,proto3" json:"MinDays,omitempty"`
+	MaxDays       float64                `protobuf:"fixed64,4,opt,name=MaxDays,proto3" json:"MaxDays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeadTime) Reset() {
+	*x = LeadTime{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeadTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeadTime) ProtoMessage() {}
+
+func (x *LeadTime) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeadTime.ProtoReflect.Descriptor instead.
+func (*LeadTime) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LeadTime) GetBookings() int64 {
+	if x != nil {
+		return x.Bookings
+	}
+	return 0
+}
+
+func (x *LeadTime) GetAverageDays() float64 {
+	if x != nil {
+		return x.AverageDays
+	}
+	return 0
+}
+
+func (x *LeadTime) GetMinDays() float64 {
+	if x != nil {
+		return x.MinDays
+	}
+	return 0
+}
+
+func (x *LeadTime) GetMaxDays() float64 {
+	if x != nil {
+		return x.MaxDays
+	}
+	return 0
+}
+
+type TdDateStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Totals        *SlotCounts            `protobuf:"bytes,1,opt,name=Totals,proto3" json:"Totals,omitempty"`
+	Days          []*DayStats            `protobuf:"bytes,2,rep,name=Days,proto3" json:"Days,omitempty"`
+	Seasons       []*SeasonStats         `protobuf:"bytes,3,rep,name=Seasons,proto3" json:"Seasons,omitempty"`
+	Weekdays      []*WeekdayStats        `protobuf:"bytes,4,rep,name=Weekdays,proto3" json:"Weekdays,omitempty"`
+	Hours         []*HourStats           `protobuf:"bytes,5,rep,name=Hours,proto3" json:"Hours,omitempty"`
+	LeadTime      *LeadTime              `protobuf:"bytes,6,opt,name=LeadTime,proto3" json:"LeadTime,omitempty"`
+	Timezone      string                 `protobuf:"bytes,7,opt,name=Timezone,proto3" json:"Timezone,omitempty"`
+	Result        *Result                `protobuf:"bytes,8,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TdDateStatsResponse) Reset() {
+	*x = TdDateStatsResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TdDateStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TdDateStatsResponse) ProtoMessage() {}
+
+func (x *TdDateStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TdDateStatsResponse.ProtoReflect.Descriptor instead.
+func (*TdDateStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TdDateStatsResponse) GetTotals() *SlotCounts {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *TdDateStatsResponse) GetDays() []*DayStats {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *TdDateStatsResponse) GetSeasons() []*SeasonStats {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *TdDateStatsResponse) GetWeekdays() []*WeekdayStats {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *TdDateStatsResponse) GetHours() []*HourStats {
+	if x != nil {
+		return x.Hours
+	}
+	return nil
+}
+
+func (x *TdDateStatsResponse) GetLeadTime() *LeadTime {
+	if x != nil {
+		return x.LeadTime
+	}
+	return nil
+}
+
+func (x *TdDateStatsResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *TdDateStatsResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type EmailReminder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailReminder) Reset() {
+	*x = EmailReminder{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailReminder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailReminder) ProtoMessage() {}
+
+func (x *EmailReminder) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailReminder.ProtoReflect.Descriptor instead.
+func (*EmailReminder) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *EmailReminder) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EmailReminder) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type EmailReminderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmailReminder *EmailReminder         `protobuf:"bytes,1,opt,name=EmailReminder,proto3" json:"EmailReminder,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailReminderResponse) Reset() {
+	*x = EmailReminderResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailReminderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailReminderResponse) ProtoMessage() {}
+
+func (x *EmailReminderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailReminderResponse.ProtoReflect.Descriptor instead.
+func (*EmailReminderResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *EmailReminderResponse) GetEmailReminder() *EmailReminder {
+	if x != nil {
+		return x.EmailReminder
+	}
+	return nil
+}
+
+func (x *EmailReminderResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type EmailReminderRepeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmailReminder []*EmailReminder       `protobuf:"bytes,1,rep,name=EmailReminder,proto3" json:"EmailReminder,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailReminderRepeatResponse) Reset() {
+	*x = EmailReminderRepeatResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailReminderRepeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailReminderRepeatResponse) ProtoMessage() {}
+
+func (x *EmailReminderRepeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailReminderRepeatResponse.ProtoReflect.Descriptor instead.
+func (*EmailReminderRepeatResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *EmailReminderRepeatResponse) GetEmailReminder() []*EmailReminder {
+	if x != nil {
+		return x.EmailReminder
+	}
+	return nil
+}
+
+func (x *EmailReminderRepeatResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type EmailReminderIDIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailReminderIDIn) Reset() {
+	*x = EmailReminderIDIn{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailReminderIDIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailReminderIDIn) ProtoMessage() {}
+
+func (x *EmailReminderIDIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailReminderIDIn.ProtoReflect.Descriptor instead.
+func (*EmailReminderIDIn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *EmailReminderIDIn) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type Household struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=Phone,proto3" json:"Phone,omitempty"`
+	Address       string                 `protobuf:"bytes,5,opt,name=Address,proto3" json:"Address,omitempty"`
+	Active        bool                   `protobuf:"varint,6,opt,name=Active,proto3" json:"Active,omitempty"`
+	Members       []*HouseholdMember     `protobuf:"bytes,7,rep,name=Members,proto3" json:"Members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Household) Reset() {
+	*x = Household{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Household) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Household) ProtoMessage() {}
+
+func (x *Household) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Household.ProtoReflect.Descriptor instead.
+func (*Household) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Household) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Household) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Household) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Household) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Household) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Household) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Household) GetMembers() []*HouseholdMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type HouseholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     *Household             `protobuf:"bytes,1,opt,name=Household,proto3" json:"Household,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseholdResponse) Reset() {
+	*x = HouseholdResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdResponse) ProtoMessage() {}
+
+func (x *HouseholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdResponse.ProtoReflect.Descriptor instead.
+func (*HouseholdResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HouseholdResponse) GetHousehold() *Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+func (x *HouseholdResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type HouseholdRepeatResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Household     []*Household           `protobuf:"bytes,1,rep,name=Household,proto3" json:"Household,omitempty"`
+	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HouseholdRepeatResponse) Reset() {
+	*x = HouseholdRepeatResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdRepeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdRepeatResponse) ProtoMessage() {}
+
+func (x *HouseholdRepeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdRepeatResponse.ProtoReflect.Descriptor instead.
+func (*HouseholdRepeatResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *HouseholdRepeatResponse) GetHousehold() []*Household {
+	if x != nil {
+		return x.Household
+	}
+	return nil
+}
+
+func (x *HouseholdRepeatResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type HouseholdIDIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TdDateResponse) Reset() {
-	*x = TdDateResponse{}
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[17]
+func (x *HouseholdIDIn) Reset() {
+	*x = HouseholdIDIn{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TdDateResponse) String() string {
+func (x *HouseholdIDIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TdDateResponse) ProtoMessage() {}
+func (*HouseholdIDIn) ProtoMessage() {}
 
-func (x *TdDateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[17]
+func (x *HouseholdIDIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1018,48 +2225,88 @@ func (x *TdDateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TdDateResponse.ProtoReflect.Descriptor instead.
-func (*TdDateResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use HouseholdIDIn.ProtoReflect.Descriptor instead.
+func (*HouseholdIDIn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *TdDateResponse) GetTdDate() *TdDate {
+func (x *HouseholdIDIn) GetId() int64 {
 	if x != nil {
-		return x.TdDate
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *TdDateResponse) GetResult() *Result {
+type UnscheduledHouseholdIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int64                  `protobuf:"varint,1,opt,name=Year,proto3" json:"Year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnscheduledHouseholdIn) Reset() {
+	*x = UnscheduledHouseholdIn{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnscheduledHouseholdIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnscheduledHouseholdIn) ProtoMessage() {}
+
+func (x *UnscheduledHouseholdIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[38]
 	if x != nil {
-		return x.Result
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type TdDateRepeatResponse struct {
+// Deprecated: Use UnscheduledHouseholdIn.ProtoReflect.Descriptor instead.
+func (*UnscheduledHouseholdIn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UnscheduledHouseholdIn) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type HouseholdMember struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TdDate        []*TdDate              `protobuf:"bytes,1,rep,name=TdDate,proto3" json:"TdDate,omitempty"`
-	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	HouseholdId   int64                  `protobuf:"varint,2,opt,name=HouseholdId,proto3" json:"HouseholdId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=Email,proto3" json:"Email,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=Phone,proto3" json:"Phone,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TdDateRepeatResponse) Reset() {
-	*x = TdDateRepeatResponse{}
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[18]
+func (x *HouseholdMember) Reset() {
+	*x = HouseholdMember{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TdDateRepeatResponse) String() string {
+func (x *HouseholdMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TdDateRepeatResponse) ProtoMessage() {}
+func (*HouseholdMember) ProtoMessage() {}
 
-func (x *TdDateRepeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[18]
+func (x *HouseholdMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,47 +2317,172 @@ func (x *TdDateRepeatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TdDateRepeatResponse.ProtoReflect.Descriptor instead.
-func (*TdDateRepeatResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use HouseholdMember.ProtoReflect.Descriptor instead.
+func (*HouseholdMember) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TdDateRepeatResponse) GetTdDate() []*TdDate {
+func (x *HouseholdMember) GetId() int64 {
 	if x != nil {
-		return x.TdDate
+		return x.Id
+	}
+	return 0
+}
+
+func (x *HouseholdMember) GetHouseholdId() int64 {
+	if x != nil {
+		return x.HouseholdId
+	}
+	return 0
+}
+
+func (x *HouseholdMember) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *HouseholdMember) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type HouseholdMemberResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdMember *HouseholdMember       `protobuf:"bytes,1,opt,name=HouseholdMember,proto3" json:"HouseholdMember,omitempty"`
+	Result          *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HouseholdMemberResponse) Reset() {
+	*x = HouseholdMemberResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMemberResponse) ProtoMessage() {}
+
+func (x *HouseholdMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMemberResponse.ProtoReflect.Descriptor instead.
+func (*HouseholdMemberResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *HouseholdMemberResponse) GetHouseholdMember() *HouseholdMember {
+	if x != nil {
+		return x.HouseholdMember
 	}
 	return nil
 }
 
-func (x *TdDateRepeatResponse) GetResult() *Result {
+func (x *HouseholdMemberResponse) GetResult() *Result {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-type TdDateIDIn struct {
+type HouseholdMemberRepeatResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HouseholdMember []*HouseholdMember     `protobuf:"bytes,1,rep,name=HouseholdMember,proto3" json:"HouseholdMember,omitempty"`
+	Result          *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *HouseholdMemberRepeatResponse) Reset() {
+	*x = HouseholdMemberRepeatResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HouseholdMemberRepeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HouseholdMemberRepeatResponse) ProtoMessage() {}
+
+func (x *HouseholdMemberRepeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HouseholdMemberRepeatResponse.ProtoReflect.Descriptor instead.
+func (*HouseholdMemberRepeatResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *HouseholdMemberRepeatResponse) GetHouseholdMember() []*HouseholdMember {
+	if x != nil {
+		return x.HouseholdMember
+	}
+	return nil
+}
+
+func (x *HouseholdMemberRepeatResponse) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type HouseholdMemberIDIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TdDateIDIn) Reset() {
-	*x = TdDateIDIn{}
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[19]
+func (x *HouseholdMemberIDIn) Reset() {
+	*x = HouseholdMemberIDIn{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TdDateIDIn) String() string {
+func (x *HouseholdMemberIDIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TdDateIDIn) ProtoMessage() {}
+func (*HouseholdMemberIDIn) ProtoMessage() {}
 
-func (x *TdDateIDIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[19]
+func (x *HouseholdMemberIDIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1121,41 +2493,43 @@ func (x *TdDateIDIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TdDateIDIn.ProtoReflect.Descriptor instead.
-func (*TdDateIDIn) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use HouseholdMemberIDIn.ProtoReflect.Descriptor instead.
+func (*HouseholdMemberIDIn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *TdDateIDIn) GetId() int64 {
+func (x *HouseholdMemberIDIn) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type EmailReminder struct {
+type BlackoutDate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	StartDate     string                 `protobuf:"bytes,3,opt,name=StartDate,proto3" json:"StartDate,omitempty"`
+	EndDate       string                 `protobuf:"bytes,4,opt,name=EndDate,proto3" json:"EndDate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmailReminder) Reset() {
-	*x = EmailReminder{}
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[20]
+func (x *BlackoutDate) Reset() {
+	*x = BlackoutDate{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmailReminder) String() string {
+func (x *BlackoutDate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailReminder) ProtoMessage() {}
+func (*BlackoutDate) ProtoMessage() {}
 
-func (x *EmailReminder) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[20]
+func (x *BlackoutDate) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1166,48 +2540,62 @@ func (x *EmailReminder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailReminder.ProtoReflect.Descriptor instead.
-func (*EmailReminder) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use BlackoutDate.ProtoReflect.Descriptor instead.
+func (*BlackoutDate) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *EmailReminder) GetId() int64 {
+func (x *BlackoutDate) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *EmailReminder) GetEmail() string {
+func (x *BlackoutDate) GetName() string {
 	if x != nil {
-		return x.Email
+		return x.Name
 	}
 	return ""
 }
 
-type EmailReminderResponse struct {
+func (x *BlackoutDate) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *BlackoutDate) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type BlackoutDateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmailReminder *EmailReminder         `protobuf:"bytes,1,opt,name=EmailReminder,proto3" json:"EmailReminder,omitempty"`
+	BlackoutDate  *BlackoutDate          `protobuf:"bytes,1,opt,name=BlackoutDate,proto3" json:"BlackoutDate,omitempty"`
 	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmailReminderResponse) Reset() {
-	*x = EmailReminderResponse{}
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[21]
+func (x *BlackoutDateResponse) Reset() {
+	*x = BlackoutDateResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmailReminderResponse) String() string {
+func (x *BlackoutDateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailReminderResponse) ProtoMessage() {}
+func (*BlackoutDateResponse) ProtoMessage() {}
 
-func (x *EmailReminderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[21]
+func (x *BlackoutDateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1218,48 +2606,48 @@ func (x *EmailReminderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailReminderResponse.ProtoReflect.Descriptor instead.
-func (*EmailReminderResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use BlackoutDateResponse.ProtoReflect.Descriptor instead.
+func (*BlackoutDateResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *EmailReminderResponse) GetEmailReminder() *EmailReminder {
+func (x *BlackoutDateResponse) GetBlackoutDate() *BlackoutDate {
 	if x != nil {
-		return x.EmailReminder
+		return x.BlackoutDate
 	}
 	return nil
 }
 
-func (x *EmailReminderResponse) GetResult() *Result {
+func (x *BlackoutDateResponse) GetResult() *Result {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-type EmailReminderRepeatResponse struct {
+type BlackoutDateRepeatResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EmailReminder []*EmailReminder       `protobuf:"bytes,1,rep,name=EmailReminder,proto3" json:"EmailReminder,omitempty"`
+	BlackoutDate  []*BlackoutDate        `protobuf:"bytes,1,rep,name=BlackoutDate,proto3" json:"BlackoutDate,omitempty"`
 	Result        *Result                `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmailReminderRepeatResponse) Reset() {
-	*x = EmailReminderRepeatResponse{}
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[22]
+func (x *BlackoutDateRepeatResponse) Reset() {
+	*x = BlackoutDateRepeatResponse{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmailReminderRepeatResponse) String() string {
+func (x *BlackoutDateRepeatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailReminderRepeatResponse) ProtoMessage() {}
+func (*BlackoutDateRepeatResponse) ProtoMessage() {}
 
-func (x *EmailReminderRepeatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[22]
+func (x *BlackoutDateRepeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,47 +2658,47 @@ func (x *EmailReminderRepeatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailReminderRepeatResponse.ProtoReflect.Descriptor instead.
-func (*EmailReminderRepeatResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use BlackoutDateRepeatResponse.ProtoReflect.Descriptor instead.
+func (*BlackoutDateRepeatResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *EmailReminderRepeatResponse) GetEmailReminder() []*EmailReminder {
+func (x *BlackoutDateRepeatResponse) GetBlackoutDate() []*BlackoutDate {
 	if x != nil {
-		return x.EmailReminder
+		return x.BlackoutDate
 	}
 	return nil
 }
 
-func (x *EmailReminderRepeatResponse) GetResult() *Result {
+func (x *BlackoutDateRepeatResponse) GetResult() *Result {
 	if x != nil {
 		return x.Result
 	}
 	return nil
 }
 
-type EmailReminderIDIn struct {
+type BlackoutDateIDIn struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmailReminderIDIn) Reset() {
-	*x = EmailReminderIDIn{}
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[23]
+func (x *BlackoutDateIDIn) Reset() {
+	*x = BlackoutDateIDIn{}
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmailReminderIDIn) String() string {
+func (x *BlackoutDateIDIn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailReminderIDIn) ProtoMessage() {}
+func (*BlackoutDateIDIn) ProtoMessage() {}
 
-func (x *EmailReminderIDIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[23]
+func (x *BlackoutDateIDIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_proto_tithe_declare_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,12 +2709,12 @@ func (x *EmailReminderIDIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailReminderIDIn.ProtoReflect.Descriptor instead.
-func (*EmailReminderIDIn) Descriptor() ([]byte, []int) {
-	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use BlackoutDateIDIn.ProtoReflect.Descriptor instead.
+func (*BlackoutDateIDIn) Descriptor() ([]byte, []int) {
+	return file_pkg_proto_tithe_declare_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *EmailReminderIDIn) GetId() int64 {
+func (x *BlackoutDateIDIn) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
@@ -1390,7 +2778,7 @@ const file_pkg_proto_tithe_declare_proto_rawDesc = "" +
 	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"A\n" +
 	"\rLoginRoleIDIn\x12\x18\n" +
 	"\aLoginId\x18\x01 \x01(\tR\aLoginId\x12\x16\n" +
-	"\x06RoleId\x18\x02 \x01(\tR\x06RoleId\"\xa4\x01\n" +
+	"\x06RoleId\x18\x02 \x01(\tR\x06RoleId\"\xe6\x01\n" +
 	"\x06TdDate\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x1c\n" +
 	"\tDateValue\x18\x02 \x01(\tR\tDateValue\x12\x12\n" +
@@ -1398,7 +2786,9 @@ const file_pkg_proto_tithe_declare_proto_rawDesc = "" +
 	"\aConfirm\x18\x04 \x01(\tR\aConfirm\x12\x12\n" +
 	"\x04Name\x18\x05 \x01(\tR\x04Name\x12\x14\n" +
 	"\x05Phone\x18\x06 \x01(\tR\x05Phone\x12\x14\n" +
-	"\x05Email\x18\a \x01(\tR\x05Email\"^\n" +
+	"\x05Email\x18\a \x01(\tR\x05Email\x12\x1a\n" +
+	"\bEndValue\x18\b \x01(\tR\bEndValue\x12$\n" +
+	"\rInterviewerId\x18\t \x01(\x03R\rInterviewerId\"^\n" +
 	"\x0eTdDateResponse\x12%\n" +
 	"\x06TdDate\x18\x01 \x01(\v2\r.proto.TdDateR\x06TdDate\x12%\n" +
 	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"d\n" +
@@ -1407,7 +2797,56 @@ const file_pkg_proto_tithe_declare_proto_rawDesc = "" +
 	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"\x1c\n" +
 	"\n" +
 	"TdDateIDIn\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\x03R\x02Id\"5\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"j\n" +
+	"\x10ExpandTemplateIn\x12.\n" +
+	"\x12ScheduleTemplateId\x18\x01 \x01(\x03R\x12ScheduleTemplateId\x12&\n" +
+	"\x0eInterviewerIds\x18\x02 \x03(\x03R\x0eInterviewerIds\"\x93\x01\n" +
+	"\x16ExpandTemplateResponse\x12\x18\n" +
+	"\aCreated\x18\x01 \x01(\x03R\aCreated\x12\x18\n" +
+	"\aSkipped\x18\x02 \x01(\x03R\aSkipped\x12%\n" +
+	"\x06result\x18\x03 \x01(\v2\r.proto.ResultR\x06result\x12\x1e\n" +
+	"\n" +
+	"BlackedOut\x18\x04 \x01(\x03R\n" +
+	"BlackedOut\"O\n" +
+	"\rTdDateStatsIn\x12\x12\n" +
+	"\x04From\x18\x01 \x01(\tR\x04From\x12\x0e\n" +
+	"\x02To\x18\x02 \x01(\tR\x02To\x12\x1a\n" +
+	"\bSeasonId\x18\x03 \x01(\x03R\bSeasonId\"\x9c\x01\n" +
+	"\n" +
+	"SlotCounts\x12\x14\n" +
+	"\x05Slots\x18\x01 \x01(\x03R\x05Slots\x12\x12\n" +
+	"\x04Held\x18\x02 \x01(\x03R\x04Held\x12\x1c\n" +
+	"\tConfirmed\x18\x03 \x01(\x03R\tConfirmed\x12\x12\n" +
+	"\x04Open\x18\x04 \x01(\x03R\x04Open\x12\x1a\n" +
+	"\bFillRate\x18\x05 \x01(\x01R\bFillRate\x12\x16\n" +
+	"\x06Closed\x18\x06 \x01(\x03R\x06Closed\"I\n" +
+	"\bDayStats\x12\x12\n" +
+	"\x04Date\x18\x01 \x01(\tR\x04Date\x12)\n" +
+	"\x06Counts\x18\x02 \x01(\v2\x11.proto.SlotCountsR\x06Counts\"h\n" +
+	"\vSeasonStats\x12\x1a\n" +
+	"\bSeasonId\x18\x01 \x01(\x03R\bSeasonId\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12)\n" +
+	"\x06Counts\x18\x03 \x01(\v2\x11.proto.SlotCountsR\x06Counts\"S\n" +
+	"\fWeekdayStats\x12\x18\n" +
+	"\aWeekday\x18\x01 \x01(\tR\aWeekday\x12)\n" +
+	"\x06Counts\x18\x02 \x01(\v2\x11.proto.SlotCountsR\x06Counts\"J\n" +
+	"\tHourStats\x12\x12\n" +
+	"\x04Hour\x18\x01 \x01(\x03R\x04Hour\x12)\n" +
+	"\x06Counts\x18\x02 \x01(\v2\x11.proto.SlotCountsR\x06Counts\"|\n" +
+	"\bLeadTime\x12\x1a\n" +
+	"\bBookings\x18\x01 \x01(\x03R\bBookings\x12 \n" +
+	"\vAverageDays\x18\x02 \x01(\x01R\vAverageDays\x12\x18\n" +
+	"\aMinDays\x18\x03 \x01(\x01R\aMinDays\x12\x18\n" +
+	"\aMaxDays\x18\x04 \x01(\x01R\aMaxDays\"\xdc\x02\n" +
+	"\x13TdDateStatsResponse\x12)\n" +
+	"\x06Totals\x18\x01 \x01(\v2\x11.proto.SlotCountsR\x06Totals\x12#\n" +
+	"\x04Days\x18\x02 \x03(\v2\x0f.proto.DayStatsR\x04Days\x12,\n" +
+	"\aSeasons\x18\x03 \x03(\v2\x12.proto.SeasonStatsR\aSeasons\x12/\n" +
+	"\bWeekdays\x18\x04 \x03(\v2\x13.proto.WeekdayStatsR\bWeekdays\x12&\n" +
+	"\x05Hours\x18\x05 \x03(\v2\x10.proto.HourStatsR\x05Hours\x12+\n" +
+	"\bLeadTime\x18\x06 \x01(\v2\x0f.proto.LeadTimeR\bLeadTime\x12\x1a\n" +
+	"\bTimezone\x18\a \x01(\tR\bTimezone\x12%\n" +
+	"\x06result\x18\b \x01(\v2\r.proto.ResultR\x06result\"5\n" +
 	"\rEmailReminder\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x14\n" +
 	"\x05Email\x18\x02 \x01(\tR\x05Email\"z\n" +
@@ -1418,6 +2857,51 @@ const file_pkg_proto_tithe_declare_proto_rawDesc = "" +
 	"\rEmailReminder\x18\x01 \x03(\v2\x14.proto.EmailReminderR\rEmailReminder\x12%\n" +
 	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"#\n" +
 	"\x11EmailReminderIDIn\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"\xbf\x01\n" +
+	"\tHousehold\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x14\n" +
+	"\x05Email\x18\x03 \x01(\tR\x05Email\x12\x14\n" +
+	"\x05Phone\x18\x04 \x01(\tR\x05Phone\x12\x18\n" +
+	"\aAddress\x18\x05 \x01(\tR\aAddress\x12\x16\n" +
+	"\x06Active\x18\x06 \x01(\bR\x06Active\x120\n" +
+	"\aMembers\x18\a \x03(\v2\x16.proto.HouseholdMemberR\aMembers\"j\n" +
+	"\x11HouseholdResponse\x12.\n" +
+	"\tHousehold\x18\x01 \x01(\v2\x10.proto.HouseholdR\tHousehold\x12%\n" +
+	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"p\n" +
+	"\x17HouseholdRepeatResponse\x12.\n" +
+	"\tHousehold\x18\x01 \x03(\v2\x10.proto.HouseholdR\tHousehold\x12%\n" +
+	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"\x1f\n" +
+	"\rHouseholdIDIn\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\",\n" +
+	"\x16UnscheduledHouseholdIn\x12\x12\n" +
+	"\x04Year\x18\x01 \x01(\x03R\x04Year\"\x83\x01\n" +
+	"\x0fHouseholdMember\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12 \n" +
+	"\vHouseholdId\x18\x02 \x01(\x03R\vHouseholdId\x12\x12\n" +
+	"\x04Name\x18\x03 \x01(\tR\x04Name\x12\x14\n" +
+	"\x05Email\x18\x04 \x01(\tR\x05Email\x12\x14\n" +
+	"\x05Phone\x18\x05 \x01(\tR\x05Phone\"\x82\x01\n" +
+	"\x17HouseholdMemberResponse\x12@\n" +
+	"\x0fHouseholdMember\x18\x01 \x01(\v2\x16.proto.HouseholdMemberR\x0fHouseholdMember\x12%\n" +
+	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"\x88\x01\n" +
+	"\x1dHouseholdMemberRepeatResponse\x12@\n" +
+	"\x0fHouseholdMember\x18\x01 \x03(\v2\x16.proto.HouseholdMemberR\x0fHouseholdMember\x12%\n" +
+	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"%\n" +
+	"\x13HouseholdMemberIDIn\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\"j\n" +
+	"\fBlackoutDate\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\x03R\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1c\n" +
+	"\tStartDate\x18\x03 \x01(\tR\tStartDate\x12\x18\n" +
+	"\aEndDate\x18\x04 \x01(\tR\aEndDate\"v\n" +
+	"\x14BlackoutDateResponse\x127\n" +
+	"\fBlackoutDate\x18\x01 \x01(\v2\x13.proto.BlackoutDateR\fBlackoutDate\x12%\n" +
+	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"|\n" +
+	"\x1aBlackoutDateRepeatResponse\x127\n" +
+	"\fBlackoutDate\x18\x01 \x03(\v2\x13.proto.BlackoutDateR\fBlackoutDate\x12%\n" +
+	"\x06result\x18\x02 \x01(\v2\r.proto.ResultR\x06result\"\"\n" +
+	"\x10BlackoutDateIDIn\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\x03R\x02Id2\xfc\x01\n" +
 	"\vRoleService\x12/\n" +
 	"\aGetRole\x12\x0f.proto.RoleIDIn\x1a\x13.proto.RoleResponse\x124\n" +
@@ -1441,19 +2925,40 @@ const file_pkg_proto_tithe_declare_proto_rawDesc = "" +
 	"\x0fCreateLoginRole\x12\x10.proto.LoginRole\x1a\x18.proto.LoginRoleResponse\x12G\n" +
 	"\rBulkLoginRole\x12\x16.proto.LoginRoleUpdate\x1a\x1e.proto.LoginRoleUpdateResponse\x122\n" +
 	"\x0fUpdateLoginRole\x12\x10.proto.LoginRole\x1a\r.proto.Result\x126\n" +
-	"\x0fDeleteLoginRole\x12\x14.proto.LoginRoleIDIn\x1a\r.proto.Result2\x98\x02\n" +
+	"\x0fDeleteLoginRole\x12\x14.proto.LoginRoleIDIn\x1a\r.proto.Result2\xab\x03\n" +
 	"\rTdDateService\x125\n" +
 	"\tGetTdDate\x12\x11.proto.TdDateIDIn\x1a\x15.proto.TdDateResponse\x12:\n" +
 	"\fSearchTdDate\x12\r.proto.TdDate\x1a\x1b.proto.TdDateRepeatResponse\x124\n" +
 	"\fCreateTdDate\x12\r.proto.TdDate\x1a\x15.proto.TdDateResponse\x12,\n" +
 	"\fUpdateTdDate\x12\r.proto.TdDate\x1a\r.proto.Result\x120\n" +
-	"\fDeleteTdDate\x12\x11.proto.TdDateIDIn\x1a\r.proto.Result2\xfa\x02\n" +
+	"\fDeleteTdDate\x12\x11.proto.TdDateIDIn\x1a\r.proto.Result\x12P\n" +
+	"\x16ExpandScheduleTemplate\x12\x17.proto.ExpandTemplateIn\x1a\x1d.proto.ExpandTemplateResponse\x12?\n" +
+	"\vStatsTdDate\x12\x14.proto.TdDateStatsIn\x1a\x1a.proto.TdDateStatsResponse2\xfa\x02\n" +
 	"\x14EmailReminderService\x12J\n" +
 	"\x10GetEmailReminder\x12\x18.proto.EmailReminderIDIn\x1a\x1c.proto.EmailReminderResponse\x12O\n" +
 	"\x13SearchEmailReminder\x12\x14.proto.EmailReminder\x1a\".proto.EmailReminderRepeatResponse\x12I\n" +
 	"\x13CreateEmailReminder\x12\x14.proto.EmailReminder\x1a\x1c.proto.EmailReminderResponse\x12:\n" +
 	"\x13UpdateEmailReminder\x12\x14.proto.EmailReminder\x1a\r.proto.Result\x12>\n" +
-	"\x13DeleteEmailReminder\x12\x18.proto.EmailReminderIDIn\x1a\r.proto.ResultB\rZ\v./;protobufb\x06proto3"
+	"\x13DeleteEmailReminder\x12\x18.proto.EmailReminderIDIn\x1a\r.proto.Result2\x99\x03\n" +
+	"\x10HouseholdService\x12>\n" +
+	"\fGetHousehold\x12\x14.proto.HouseholdIDIn\x1a\x18.proto.HouseholdResponse\x12C\n" +
+	"\x0fSearchHousehold\x12\x10.proto.Household\x1a\x1e.proto.HouseholdRepeatResponse\x12=\n" +
+	"\x0fCreateHousehold\x12\x10.proto.Household\x1a\x18.proto.HouseholdResponse\x122\n" +
+	"\x0fUpdateHousehold\x12\x10.proto.Household\x1a\r.proto.Result\x126\n" +
+	"\x0fDeleteHousehold\x12\x14.proto.HouseholdIDIn\x1a\r.proto.Result\x12U\n" +
+	"\x14UnscheduledHousehold\x12\x1d.proto.UnscheduledHouseholdIn\x1a\x1e.proto.HouseholdRepeatResponse2\x96\x03\n" +
+	"\x16HouseholdMemberService\x12P\n" +
+	"\x12GetHouseholdMember\x12\x1a.proto.HouseholdMemberIDIn\x1a\x1e.proto.HouseholdMemberResponse\x12U\n" +
+	"\x15SearchHouseholdMember\x12\x16.proto.HouseholdMember\x1a$.proto.HouseholdMemberRepeatResponse\x12O\n" +
+	"\x15CreateHouseholdMember\x12\x16.proto.HouseholdMember\x1a\x1e.proto.HouseholdMemberResponse\x12>\n" +
+	"\x15UpdateHouseholdMember\x12\x16.proto.HouseholdMember\x1a\r.proto.Result\x12B\n" +
+	"\x15DeleteHouseholdMember\x12\x1a.proto.HouseholdMemberIDIn\x1a\r.proto.Result2\xec\x02\n" +
+	"\x13BlackoutDateService\x12G\n" +
+	"\x0fGetBlackoutDate\x12\x17.proto.BlackoutDateIDIn\x1a\x1b.proto.BlackoutDateResponse\x12L\n" +
+	"\x12SearchBlackoutDate\x12\x13.proto.BlackoutDate\x1a!.proto.BlackoutDateRepeatResponse\x12F\n" +
+	"\x12CreateBlackoutDate\x12\x13.proto.BlackoutDate\x1a\x1b.proto.BlackoutDateResponse\x128\n" +
+	"\x12UpdateBlackoutDate\x12\x13.proto.BlackoutDate\x1a\r.proto.Result\x12<\n" +
+	"\x12DeleteBlackoutDate\x12\x17.proto.BlackoutDateIDIn\x1a\r.proto.ResultB\rZ\v./;protobufb\x06proto3"
 
 var (
 	file_pkg_proto_tithe_declare_proto_rawDescOnce sync.Once
@@ -1467,32 +2972,55 @@ func file_pkg_proto_tithe_declare_proto_rawDescGZIP() []byte {
 	return file_pkg_proto_tithe_declare_proto_rawDescData
 }
 
-var file_pkg_proto_tithe_declare_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_pkg_proto_tithe_declare_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_pkg_proto_tithe_declare_proto_goTypes = []any{
-	(*IDIn)(nil),                        // 0: proto.IDIn
-	(*Result)(nil),                      // 1: proto.Result
-	(*Role)(nil),                        // 2: proto.Role
-	(*RoleResponse)(nil),                // 3: proto.RoleResponse
-	(*RoleRepeatResponse)(nil),          // 4: proto.RoleRepeatResponse
-	(*RoleIDIn)(nil),                    // 5: proto.RoleIDIn
-	(*Login)(nil),                       // 6: proto.Login
-	(*LoginResponse)(nil),               // 7: proto.LoginResponse
-	(*LoginRepeatResponse)(nil),         // 8: proto.LoginRepeatResponse
-	(*LoginIDIn)(nil),                   // 9: proto.LoginIDIn
-	(*LoginRole)(nil),                   // 10: proto.LoginRole
-	(*LoginRoleUpdate)(nil),             // 11: proto.LoginRoleUpdate
-	(*LoginRoleResponse)(nil),           // 12: proto.LoginRoleResponse
-	(*LoginRoleUpdateResponse)(nil),     // 13: proto.LoginRoleUpdateResponse
-	(*LoginRoleRepeatResponse)(nil),     // 14: proto.LoginRoleRepeatResponse
-	(*LoginRoleIDIn)(nil),               // 15: proto.LoginRoleIDIn
-	(*TdDate)(nil),                      // 16: proto.TdDate
-	(*TdDateResponse)(nil),              // 17: proto.TdDateResponse
-	(*TdDateRepeatResponse)(nil),        // 18: proto.TdDateRepeatResponse
-	(*TdDateIDIn)(nil),                  // 19: proto.TdDateIDIn
-	(*EmailReminder)(nil),               // 20: proto.EmailReminder
-	(*EmailReminderResponse)(nil),       // 21: proto.EmailReminderResponse
-	(*EmailReminderRepeatResponse)(nil), // 22: proto.EmailReminderRepeatResponse
-	(*EmailReminderIDIn)(nil),           // 23: proto.EmailReminderIDIn
+	(*IDIn)(nil),                          // 0: proto.IDIn
+	(*Result)(nil),                        // 1: proto.Result
+	(*Role)(nil),                          // 2: proto.Role
+	(*RoleResponse)(nil),                  // 3: proto.RoleResponse
+	(*RoleRepeatResponse)(nil),            // 4: proto.RoleRepeatResponse
+	(*RoleIDIn)(nil),                      // 5: proto.RoleIDIn
+	(*Login)(nil),                         // 6: proto.Login
+	(*LoginResponse)(nil),                 // 7: proto.LoginResponse
+	(*LoginRepeatResponse)(nil),           // 8: proto.LoginRepeatResponse
+	(*LoginIDIn)(nil),                     // 9: proto.LoginIDIn
+	(*LoginRole)(nil),                     // 10: proto.LoginRole
+	(*LoginRoleUpdate)(nil),               // 11: proto.LoginRoleUpdate
+	(*LoginRoleResponse)(nil),             // 12: proto.LoginRoleResponse
+	(*LoginRoleUpdateResponse)(nil),       // 13: proto.LoginRoleUpdateResponse
+	(*LoginRoleRepeatResponse)(nil),       // 14: proto.LoginRoleRepeatResponse
+	(*LoginRoleIDIn)(nil),                 // 15: proto.LoginRoleIDIn
+	(*TdDate)(nil),                        // 16: proto.TdDate
+	(*TdDateResponse)(nil),                // 17: proto.TdDateResponse
+	(*TdDateRepeatResponse)(nil),          // 18: proto.TdDateRepeatResponse
+	(*TdDateIDIn)(nil),                    // 19: proto.TdDateIDIn
+	(*ExpandTemplateIn)(nil),              // 20: proto.ExpandTemplateIn
+	(*ExpandTemplateResponse)(nil),        // 21: proto.ExpandTemplateResponse
+	(*TdDateStatsIn)(nil),                 // 22: proto.TdDateStatsIn
+	(*SlotCounts)(nil),                    // 23: proto.SlotCounts
+	(*DayStats)(nil),                      // 24: proto.DayStats
+	(*SeasonStats)(nil),                   // 25: proto.SeasonStats
+	(*WeekdayStats)(nil),                  // 26: proto.WeekdayStats
+	(*HourStats)(nil),                     // 27: proto.HourStats
+	(*LeadTime)(nil),                      // 28: proto.LeadTime
+	(*TdDateStatsResponse)(nil),           // 29: proto.TdDateStatsResponse
+	(*EmailReminder)(nil),                 // 30: proto.EmailReminder
+	(*EmailReminderResponse)(nil),         // 31: proto.EmailReminderResponse
+	(*EmailReminderRepeatResponse)(nil),   // 32: proto.EmailReminderRepeatResponse
+	(*EmailReminderIDIn)(nil),             // 33: proto.EmailReminderIDIn
+	(*Household)(nil),                     // 34: proto.Household
+	(*HouseholdResponse)(nil),             // 35: proto.HouseholdResponse
+	(*HouseholdRepeatResponse)(nil),       // 36: proto.HouseholdRepeatResponse
+	(*HouseholdIDIn)(nil),                 // 37: proto.HouseholdIDIn
+	(*UnscheduledHouseholdIn)(nil),        // 38: proto.UnscheduledHouseholdIn
+	(*HouseholdMember)(nil),               // 39: proto.HouseholdMember
+	(*HouseholdMemberResponse)(nil),       // 40: proto.HouseholdMemberResponse
+	(*HouseholdMemberRepeatResponse)(nil), // 41: proto.HouseholdMemberRepeatResponse
+	(*HouseholdMemberIDIn)(nil),           // 42: proto.HouseholdMemberIDIn
+	(*BlackoutDate)(nil),                  // 43: proto.BlackoutDate
+	(*BlackoutDateResponse)(nil),          // 44: proto.BlackoutDateResponse
+	(*BlackoutDateRepeatResponse)(nil),    // 45: proto.BlackoutDateRepeatResponse
+	(*BlackoutDateIDIn)(nil),              // 46: proto.BlackoutDateIDIn
 }
 var file_pkg_proto_tithe_declare_proto_depIdxs = []int32{
 	2,  // 0: proto.RoleResponse.Role:type_name -> proto.Role
@@ -1513,67 +3041,128 @@ var file_pkg_proto_tithe_declare_proto_depIdxs = []int32{
 	1,  // 15: proto.TdDateResponse.result:type_name -> proto.Result
 	16, // 16: proto.TdDateRepeatResponse.TdDate:type_name -> proto.TdDate
 	1,  // 17: proto.TdDateRepeatResponse.result:type_name -> proto.Result
-	20, // 18: proto.EmailReminderResponse.EmailReminder:type_name -> proto.EmailReminder
-	1,  // 19: proto.EmailReminderResponse.result:type_name -> proto.Result
-	20, // 20: proto.EmailReminderRepeatResponse.EmailReminder:type_name -> proto.EmailReminder
-	1,  // 21: proto.EmailReminderRepeatResponse.result:type_name -> proto.Result
-	5,  // 22: proto.RoleService.GetRole:input_type -> proto.RoleIDIn
-	2,  // 23: proto.RoleService.SearchRole:input_type -> proto.Role
-	2,  // 24: proto.RoleService.CreateRole:input_type -> proto.Role
-	2,  // 25: proto.RoleService.UpdateRole:input_type -> proto.Role
-	5,  // 26: proto.RoleService.DeleteRole:input_type -> proto.RoleIDIn
-	9,  // 27: proto.LoginService.GetLogin:input_type -> proto.LoginIDIn
-	6,  // 28: proto.LoginService.SearchLogin:input_type -> proto.Login
-	6,  // 29: proto.LoginService.CreateLogin:input_type -> proto.Login
-	6,  // 30: proto.LoginService.UpdateLogin:input_type -> proto.Login
-	9,  // 31: proto.LoginService.DeleteLogin:input_type -> proto.LoginIDIn
-	15, // 32: proto.LoginRoleService.GetLoginRole:input_type -> proto.LoginRoleIDIn
-	10, // 33: proto.LoginRoleService.SearchLoginRole:input_type -> proto.LoginRole
-	10, // 34: proto.LoginRoleService.CreateLoginRole:input_type -> proto.LoginRole
-	11, // 35: proto.LoginRoleService.BulkLoginRole:input_type -> proto.LoginRoleUpdate
-	10, // 36: proto.LoginRoleService.UpdateLoginRole:input_type -> proto.LoginRole
-	15, // 37: proto.LoginRoleService.DeleteLoginRole:input_type -> proto.LoginRoleIDIn
-	19, // 38: proto.TdDateService.GetTdDate:input_type -> proto.TdDateIDIn
-	16, // 39: proto.TdDateService.SearchTdDate:input_type -> proto.TdDate
-	16, // 40: proto.TdDateService.CreateTdDate:input_type -> proto.TdDate
-	16, // 41: proto.TdDateService.UpdateTdDate:input_type -> proto.TdDate
-	19, // 42: proto.TdDateService.DeleteTdDate:input_type -> proto.TdDateIDIn
-	23, // 43: proto.EmailReminderService.GetEmailReminder:input_type -> proto.EmailReminderIDIn
-	20, // 44: proto.EmailReminderService.SearchEmailReminder:input_type -> proto.EmailReminder
-	20, // 45: proto.EmailReminderService.CreateEmailReminder:input_type -> proto.EmailReminder
-	20, // 46: proto.EmailReminderService.UpdateEmailReminder:input_type -> proto.EmailReminder
-	23, // 47: proto.EmailReminderService.DeleteEmailReminder:input_type -> proto.EmailReminderIDIn
-	3,  // 48: proto.RoleService.GetRole:output_type -> proto.RoleResponse
-	4,  // 49: proto.RoleService.SearchRole:output_type -> proto.RoleRepeatResponse
-	3,  // 50: proto.RoleService.CreateRole:output_type -> proto.RoleResponse
-	1,  // 51: proto.RoleService.UpdateRole:output_type -> proto.Result
-	1,  // 52: proto.RoleService.DeleteRole:output_type -> proto.Result
-	7,  // 53: proto.LoginService.GetLogin:output_type -> proto.LoginResponse
-	8,  // 54: proto.LoginService.SearchLogin:output_type -> proto.LoginRepeatResponse
-	7,  // 55: proto.LoginService.CreateLogin:output_type -> proto.LoginResponse
-	1,  // 56: proto.LoginService.UpdateLogin:output_type -> proto.Result
-	1,  // 57: proto.LoginService.DeleteLogin:output_type -> proto.Result
-	12, // 58: proto.LoginRoleService.GetLoginRole:output_type -> proto.LoginRoleResponse
-	14, // 59: proto.LoginRoleService.SearchLoginRole:output_type -> proto.LoginRoleRepeatResponse
-	12, // 60: proto.LoginRoleService.CreateLoginRole:output_type -> proto.LoginRoleResponse
-	13, // 61: proto.LoginRoleService.BulkLoginRole:output_type -> proto.LoginRoleUpdateResponse
-	1,  // 62: proto.LoginRoleService.UpdateLoginRole:output_type -> proto.Result
-	1,  // 63: proto.LoginRoleService.DeleteLoginRole:output_type -> proto.Result
-	17, // 64: proto.TdDateService.GetTdDate:output_type -> proto.TdDateResponse
-	18, // 65: proto.TdDateService.SearchTdDate:output_type -> proto.TdDateRepeatResponse
-	17, // 66: proto.TdDateService.CreateTdDate:output_type -> proto.TdDateResponse
-	1,  // 67: proto.TdDateService.UpdateTdDate:output_type -> proto.Result
-	1,  // 68: proto.TdDateService.DeleteTdDate:output_type -> proto.Result
-	21, // 69: proto.EmailReminderService.GetEmailReminder:output_type -> proto.EmailReminderResponse
-	22, // 70: proto.EmailReminderService.SearchEmailReminder:output_type -> proto.EmailReminderRepeatResponse
-	21, // 71: proto.EmailReminderService.CreateEmailReminder:output_type -> proto.EmailReminderResponse
-	1,  // 72: proto.EmailReminderService.UpdateEmailReminder:output_type -> proto.Result
-	1,  // 73: proto.EmailReminderService.DeleteEmailReminder:output_type -> proto.Result
-	48, // [48:74] is the sub-list for method output_type
-	22, // [22:48] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	1,  // 18: proto.ExpandTemplateResponse.result:type_name -> proto.Result
+	23, // 19: proto.DayStats.Counts:type_name -> proto.SlotCounts
+	23, // 20: proto.SeasonStats.Counts:type_name -> proto.SlotCounts
+	23, // 21: proto.WeekdayStats.Counts:type_name -> proto.SlotCounts
+	23, // 22: proto.HourStats.Counts:type_name -> proto.SlotCounts
+	23, // 23: proto.TdDateStatsResponse.Totals:type_name -> proto.SlotCounts
+	24, // 24: proto.TdDateStatsResponse.Days:type_name -> proto.DayStats
+	25, // 25: proto.TdDateStatsResponse.Seasons:type_name -> proto.SeasonStats
+	26, // 26: proto.TdDateStatsResponse.Weekdays:type_name -> proto.WeekdayStats
+	27, // 27: proto.TdDateStatsResponse.Hours:type_name -> proto.HourStats
+	28, // 28: proto.TdDateStatsResponse.LeadTime:type_name -> proto.LeadTime
+	1,  // 29: proto.TdDateStatsResponse.result:type_name -> proto.Result
+	30, // 30: proto.EmailReminderResponse.EmailReminder:type_name -> proto.EmailReminder
+	1,  // 31: proto.EmailReminderResponse.result:type_name -> proto.Result
+	30, // 32: proto.EmailReminderRepeatResponse.EmailReminder:type_name -> proto.EmailReminder
+	1,  // 33: proto.EmailReminderRepeatResponse.result:type_name -> proto.Result
+	39, // 34: proto.Household.Members:type_name -> proto.HouseholdMember
+	34, // 35: proto.HouseholdResponse.Household:type_name -> proto.Household
+	1,  // 36: proto.HouseholdResponse.result:type_name -> proto.Result
+	34, // 37: proto.HouseholdRepeatResponse.Household:type_name -> proto.Household
+	1,  // 38: proto.HouseholdRepeatResponse.result:type_name -> proto.Result
+	39, // 39: proto.HouseholdMemberResponse.HouseholdMember:type_name -> proto.HouseholdMember
+	1,  // 40: proto.HouseholdMemberResponse.result:type_name -> proto.Result
+	39, // 41: proto.HouseholdMemberRepeatResponse.HouseholdMember:type_name -> proto.HouseholdMember
+	1,  // 42: proto.HouseholdMemberRepeatResponse.result:type_name -> proto.Result
+	43, // 43: proto.BlackoutDateResponse.BlackoutDate:type_name -> proto.BlackoutDate
+	1,  // 44: proto.BlackoutDateResponse.result:type_name -> proto.Result
+	43, // 45: proto.BlackoutDateRepeatResponse.BlackoutDate:type_name -> proto.BlackoutDate
+	1,  // 46: proto.BlackoutDateRepeatResponse.result:type_name -> proto.Result
+	5,  // 47: proto.RoleService.GetRole:input_type -> proto.RoleIDIn
+	2,  // 48: proto.RoleService.SearchRole:input_type -> proto.Role
+	2,  // 49: proto.RoleService.CreateRole:input_type -> proto.Role
+	2,  // 50: proto.RoleService.UpdateRole:input_type -> proto.Role
+	5,  // 51: proto.RoleService.DeleteRole:input_type -> proto.RoleIDIn
+	9,  // 52: proto.LoginService.GetLogin:input_type -> proto.LoginIDIn
+	6,  // 53: proto.LoginService.SearchLogin:input_type -> proto.Login
+	6,  // 54: proto.LoginService.CreateLogin:input_type -> proto.Login
+	6,  // 55: proto.LoginService.UpdateLogin:input_type -> proto.Login
+	9,  // 56: proto.LoginService.DeleteLogin:input_type -> proto.LoginIDIn
+	15, // 57: proto.LoginRoleService.GetLoginRole:input_type -> proto.LoginRoleIDIn
+	10, // 58: proto.LoginRoleService.SearchLoginRole:input_type -> proto.LoginRole
+	10, // 59: proto.LoginRoleService.CreateLoginRole:input_type -> proto.LoginRole
+	11, // 60: proto.LoginRoleService.BulkLoginRole:input_type -> proto.LoginRoleUpdate
+	10, // 61: proto.LoginRoleService.UpdateLoginRole:input_type -> proto.LoginRole
+	15, // 62: proto.LoginRoleService.DeleteLoginRole:input_type -> proto.LoginRoleIDIn
+	19, // 63: proto.TdDateService.GetTdDate:input_type -> proto.TdDateIDIn
+	16, // 64: proto.TdDateService.SearchTdDate:input_type -> proto.TdDate
+	16, // 65: proto.TdDateService.CreateTdDate:input_type -> proto.TdDate
+	16, // 66: proto.TdDateService.UpdateTdDate:input_type -> proto.TdDate
+	19, // 67: proto.TdDateService.DeleteTdDate:input_type -> proto.TdDateIDIn
+	20, // 68: proto.TdDateService.ExpandScheduleTemplate:input_type -> proto.ExpandTemplateIn
+	22, // 69: proto.TdDateService.StatsTdDate:input_type -> proto.TdDateStatsIn
+	33, // 70: proto.EmailReminderService.GetEmailReminder:input_type -> proto.EmailReminderIDIn
+	30, // 71: proto.EmailReminderService.SearchEmailReminder:input_type -> proto.EmailReminder
+	30, // 72: proto.EmailReminderService.CreateEmailReminder:input_type -> proto.EmailReminder
+	30, // 73: proto.EmailReminderService.UpdateEmailReminder:input_type -> proto.EmailReminder
+	33, // 74: proto.EmailReminderService.DeleteEmailReminder:input_type -> proto.EmailReminderIDIn
+	37, // 75: proto.HouseholdService.GetHousehold:input_type -> proto.HouseholdIDIn
+	34, // 76: proto.HouseholdService.SearchHousehold:input_type -> proto.Household
+	34, // 77: proto.HouseholdService.CreateHousehold:input_type -> proto.Household
+	34, // 78: proto.HouseholdService.UpdateHousehold:input_type -> proto.Household
+	37, // 79: proto.HouseholdService.DeleteHousehold:input_type -> proto.HouseholdIDIn
+	38, // 80: proto.HouseholdService.UnscheduledHousehold:input_type -> proto.UnscheduledHouseholdIn
+	42, // 81: proto.HouseholdMemberService.GetHouseholdMember:input_type -> proto.HouseholdMemberIDIn
+	39, // 82: proto.HouseholdMemberService.SearchHouseholdMember:input_type -> proto.HouseholdMember
+	39, // 83: proto.HouseholdMemberService.CreateHouseholdMember:input_type -> proto.HouseholdMember
+	39, // 84: proto.HouseholdMemberService.UpdateHouseholdMember:input_type -> proto.HouseholdMember
+	42, // 85: proto.HouseholdMemberService.DeleteHouseholdMember:input_type -> proto.HouseholdMemberIDIn
+	46, // 86: proto.BlackoutDateService.GetBlackoutDate:input_type -> proto.BlackoutDateIDIn
+	43, // 87: proto.BlackoutDateService.SearchBlackoutDate:input_type -> proto.BlackoutDate
+	43, // 88: proto.BlackoutDateService.CreateBlackoutDate:input_type -> proto.BlackoutDate
+	43, // 89: proto.BlackoutDateService.UpdateBlackoutDate:input_type -> proto.BlackoutDate
+	46, // 90: proto.BlackoutDateService.DeleteBlackoutDate:input_type -> proto.BlackoutDateIDIn
+	3,  // 91: proto.RoleService.GetRole:output_type -> proto.RoleResponse
+	4,  // 92: proto.RoleService.SearchRole:output_type -> proto.RoleRepeatResponse
+	3,  // 93: proto.RoleService.CreateRole:output_type -> proto.RoleResponse
+	1,  // 94: proto.RoleService.UpdateRole:output_type -> proto.Result
+	1,  // 95: proto.RoleService.DeleteRole:output_type -> proto.Result
+	7,  // 96: proto.LoginService.GetLogin:output_type -> proto.LoginResponse
+	8,  // 97: proto.LoginService.SearchLogin:output_type -> proto.LoginRepeatResponse
+	7,  // 98: proto.LoginService.CreateLogin:output_type -> proto.LoginResponse
+	1,  // 99: proto.LoginService.UpdateLogin:output_type -> proto.Result
+	1,  // 100: proto.LoginService.DeleteLogin:output_type -> proto.Result
+	12, // 101: proto.LoginRoleService.GetLoginRole:output_type -> proto.LoginRoleResponse
+	14, // 102: proto.LoginRoleService.SearchLoginRole:output_type -> proto.LoginRoleRepeatResponse
+	12, // 103: proto.LoginRoleService.CreateLoginRole:output_type -> proto.LoginRoleResponse
+	13, // 104: proto.LoginRoleService.BulkLoginRole:output_type -> proto.LoginRoleUpdateResponse
+	1,  // 105: proto.LoginRoleService.UpdateLoginRole:output_type -> proto.Result
+	1,  // 106: proto.LoginRoleService.DeleteLoginRole:output_type -> proto.Result
+	17, // 107: proto.TdDateService.GetTdDate:output_type -> proto.TdDateResponse
+	18, // 108: proto.TdDateService.SearchTdDate:output_type -> proto.TdDateRepeatResponse
+	17, // 109: proto.TdDateService.CreateTdDate:output_type -> proto.TdDateResponse
+	1,  // 110: proto.TdDateService.UpdateTdDate:output_type -> proto.Result
+	1,  // 111: proto.TdDateService.DeleteTdDate:output_type -> proto.Result
+	21, // 112: proto.TdDateService.ExpandScheduleTemplate:output_type -> proto.ExpandTemplateResponse
+	29, // 113: proto.TdDateService.StatsTdDate:output_type -> proto.TdDateStatsResponse
+	31, // 114: proto.EmailReminderService.GetEmailReminder:output_type -> proto.EmailReminderResponse
+	32, // 115: proto.EmailReminderService.SearchEmailReminder:output_type -> proto.EmailReminderRepeatResponse
+	31, // 116: proto.EmailReminderService.CreateEmailReminder:output_type -> proto.EmailReminderResponse
+	1,  // 117: proto.EmailReminderService.UpdateEmailReminder:output_type -> proto.Result
+	1,  // 118: proto.EmailReminderService.DeleteEmailReminder:output_type -> proto.Result
+	35, // 119: proto.HouseholdService.GetHousehold:output_type -> proto.HouseholdResponse
+	36, // 120: proto.HouseholdService.SearchHousehold:output_type -> proto.HouseholdRepeatResponse
+	35, // 121: proto.HouseholdService.CreateHousehold:output_type -> proto.HouseholdResponse
+	1,  // 122: proto.HouseholdService.UpdateHousehold:output_type -> proto.Result
+	1,  // 123: proto.HouseholdService.DeleteHousehold:output_type -> proto.Result
+	36, // 124: proto.HouseholdService.UnscheduledHousehold:output_type -> proto.HouseholdRepeatResponse
+	40, // 125: proto.HouseholdMemberService.GetHouseholdMember:output_type -> proto.HouseholdMemberResponse
+	41, // 126: proto.HouseholdMemberService.SearchHouseholdMember:output_type -> proto.HouseholdMemberRepeatResponse
+	40, // 127: proto.HouseholdMemberService.CreateHouseholdMember:output_type -> proto.HouseholdMemberResponse
+	1,  // 128: proto.HouseholdMemberService.UpdateHouseholdMember:output_type -> proto.Result
+	1,  // 129: proto.HouseholdMemberService.DeleteHouseholdMember:output_type -> proto.Result
+	44, // 130: proto.BlackoutDateService.GetBlackoutDate:output_type -> proto.BlackoutDateResponse
+	45, // 131: proto.BlackoutDateService.SearchBlackoutDate:output_type -> proto.BlackoutDateRepeatResponse
+	44, // 132: proto.BlackoutDateService.CreateBlackoutDate:output_type -> proto.BlackoutDateResponse
+	1,  // 133: proto.BlackoutDateService.UpdateBlackoutDate:output_type -> proto.Result
+	1,  // 134: proto.BlackoutDateService.DeleteBlackoutDate:output_type -> proto.Result
+	91, // [91:135] is the sub-list for method output_type
+	47, // [47:91] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_pkg_proto_tithe_declare_proto_init() }
@@ -1587,9 +3176,9 @@ func file_pkg_proto_tithe_declare_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pkg_proto_tithe_declare_proto_rawDesc), len(file_pkg_proto_tithe_declare_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   47,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   8,
 		},
 		GoTypes:           file_pkg_proto_tithe_declare_proto_goTypes,
 		DependencyIndexes: file_pkg_proto_tithe_declare_proto_depIdxs,
@@ -819,11 +819,13 @@ var LoginRoleService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TdDateService_GetTdDate_FullMethodName    = "/proto.TdDateService/GetTdDate"
-	TdDateService_SearchTdDate_FullMethodName = "/proto.TdDateService/SearchTdDate"
-	TdDateService_CreateTdDate_FullMethodName = "/proto.TdDateService/CreateTdDate"
-	TdDateService_UpdateTdDate_FullMethodName = "/proto.TdDateService/UpdateTdDate"
-	TdDateService_DeleteTdDate_FullMethodName = "/proto.TdDateService/DeleteTdDate"
+	TdDateService_GetTdDate_FullMethodName              = "/proto.TdDateService/GetTdDate"
+	TdDateService_SearchTdDate_FullMethodName           = "/proto.TdDateService/SearchTdDate"
+	TdDateService_CreateTdDate_FullMethodName           = "/proto.TdDateService/CreateTdDate"
+	TdDateService_UpdateTdDate_FullMethodName           = "/proto.TdDateService/UpdateTdDate"
+	TdDateService_DeleteTdDate_FullMethodName           = "/proto.TdDateService/DeleteTdDate"
+	TdDateService_ExpandScheduleTemplate_FullMethodName = "/proto.TdDateService/ExpandScheduleTemplate"
+	TdDateService_StatsTdDate_FullMethodName            = "/proto.TdDateService/StatsTdDate"
 )
 
 // TdDateServiceClient is the client API for TdDateService service.
@@ -835,6 +837,8 @@ type TdDateServiceClient interface {
 	CreateTdDate(ctx context.Context, in *TdDate, opts ...grpc.CallOption) (*TdDateResponse, error)
 	UpdateTdDate(ctx context.Context, in *TdDate, opts ...grpc.CallOption) (*Result, error)
 	DeleteTdDate(ctx context.Context, in *TdDateIDIn, opts ...grpc.CallOption) (*Result, error)
+	ExpandScheduleTemplate(ctx context.Context, in *ExpandTemplateIn, opts ...grpc.CallOption) (*ExpandTemplateResponse, error)
+	StatsTdDate(ctx context.Context, in *TdDateStatsIn, opts ...grpc.CallOption) (*TdDateStatsResponse, error)
 }
 
 type tdDateServiceClient struct {
@@ -895,6 +899,26 @@ func (c *tdDateServiceClient) DeleteTdDate(ctx context.Context, in *TdDateIDIn,
 	return out, nil
 }
 
+func (c *tdDateServiceClient) ExpandScheduleTemplate(ctx context.Context, in *ExpandTemplateIn, opts ...grpc.CallOption) (*ExpandTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpandTemplateResponse)
+	err := c.cc.Invoke(ctx, TdDateService_ExpandScheduleTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tdDateServiceClient) StatsTdDate(ctx context.Context, in *TdDateStatsIn, opts ...grpc.CallOption) (*TdDateStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TdDateStatsResponse)
+	err := c.cc.Invoke(ctx, TdDateService_StatsTdDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TdDateServiceServer is the server API for TdDateService service.
 // All implementations must embed UnimplementedTdDateServiceServer
 // for forward compatibility.
@@ -904,6 +928,8 @@ type TdDateServiceServer interface {
 	CreateTdDate(context.Context, *TdDate) (*TdDateResponse, error)
 	UpdateTdDate(context.Context, *TdDate) (*Result, error)
 	DeleteTdDate(context.Context, *TdDateIDIn) (*Result, error)
+	ExpandScheduleTemplate(context.Context, *ExpandTemplateIn) (*ExpandTemplateResponse, error)
+	StatsTdDate(context.Context, *TdDateStatsIn) (*TdDateStatsResponse, error)
 	mustEmbedUnimplementedTdDateServiceServer()
 }
 
@@ -929,6 +955,12 @@ func (UnimplementedTdDateServiceServer) UpdateTdDate(context.Context, *TdDate) (
 func (UnimplementedTdDateServiceServer) DeleteTdDate(context.Context, *TdDateIDIn) (*Result, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTdDate not implemented")
 }
+func (UnimplementedTdDateServiceServer) ExpandScheduleTemplate(context.Context, *ExpandTemplateIn) (*ExpandTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandScheduleTemplate not implemented")
+}
+func (UnimplementedTdDateServiceServer) StatsTdDate(context.Context, *TdDateStatsIn) (*TdDateStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsTdDate not implemented")
+}
 func (UnimplementedTdDateServiceServer) mustEmbedUnimplementedTdDateServiceServer() {}
 func (UnimplementedTdDateServiceServer) testEmbeddedByValue()                       {}
 
@@ -1040,6 +1072,42 @@ func _TdDateService_DeleteTdDate_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TdDateService_ExpandScheduleTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandTemplateIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TdDateServiceServer).ExpandScheduleTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TdDateService_ExpandScheduleTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TdDateServiceServer).ExpandScheduleTemplate(ctx, req.(*ExpandTemplateIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TdDateService_StatsTdDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TdDateStatsIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TdDateServiceServer).StatsTdDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TdDateService_StatsTdDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TdDateServiceServer).StatsTdDate(ctx, req.(*TdDateStatsIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TdDateService_ServiceDesc is the grpc.ServiceDesc for TdDateService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1067,6 +1135,14 @@ var TdDateService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTdDate",
 			Handler:    _TdDateService_DeleteTdDate_Handler,
 		},
+		{
+			MethodName: "ExpandScheduleTemplate",
+			Handler:    _TdDateService_ExpandScheduleTemplate_Handler,
+		},
+		{
+			MethodName: "StatsTdDate",
+			Handler:    _TdDateService_StatsTdDate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/tithe-declare.proto",
@@ -1325,3 +1401,804 @@ var EmailReminderService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/proto/tithe-declare.proto",
 }
+
+const (
+	HouseholdService_GetHousehold_FullMethodName         = "/proto.HouseholdService/GetHousehold"
+	HouseholdService_SearchHousehold_FullMethodName      = "/proto.HouseholdService/SearchHousehold"
+	HouseholdService_CreateHousehold_FullMethodName      = "/proto.HouseholdService/CreateHousehold"
+	HouseholdService_UpdateHousehold_FullMethodName      = "/proto.HouseholdService/UpdateHousehold"
+	HouseholdService_DeleteHousehold_FullMethodName      = "/proto.HouseholdService/DeleteHousehold"
+	HouseholdService_UnscheduledHousehold_FullMethodName = "/proto.HouseholdService/UnscheduledHousehold"
+)
+
+// HouseholdServiceClient is the client API for HouseholdService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HouseholdServiceClient interface {
+	GetHousehold(ctx context.Context, in *HouseholdIDIn, opts ...grpc.CallOption) (*HouseholdResponse, error)
+	SearchHousehold(ctx context.Context, in *Household, opts ...grpc.CallOption) (*HouseholdRepeatResponse, error)
+	CreateHousehold(ctx context.Context, in *Household, opts ...grpc.CallOption) (*HouseholdResponse, error)
+	UpdateHousehold(ctx context.Context, in *Household, opts ...grpc.CallOption) (*Result, error)
+	DeleteHousehold(ctx context.Context, in *HouseholdIDIn, opts ...grpc.CallOption) (*Result, error)
+	UnscheduledHousehold(ctx context.Context, in *UnscheduledHouseholdIn, opts ...grpc.CallOption) (*HouseholdRepeatResponse, error)
+}
+
+type householdServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHouseholdServiceClient(cc grpc.ClientConnInterface) HouseholdServiceClient {
+	return &householdServiceClient{cc}
+}
+
+func (c *householdServiceClient) GetHousehold(ctx context.Context, in *HouseholdIDIn, opts ...grpc.CallOption) (*HouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_GetHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) SearchHousehold(ctx context.Context, in *Household, opts ...grpc.CallOption) (*HouseholdRepeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdRepeatResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_SearchHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) CreateHousehold(ctx context.Context, in *Household, opts ...grpc.CallOption) (*HouseholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_CreateHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) UpdateHousehold(ctx context.Context, in *Household, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, HouseholdService_UpdateHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) DeleteHousehold(ctx context.Context, in *HouseholdIDIn, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, HouseholdService_DeleteHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdServiceClient) UnscheduledHousehold(ctx context.Context, in *UnscheduledHouseholdIn, opts ...grpc.CallOption) (*HouseholdRepeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdRepeatResponse)
+	err := c.cc.Invoke(ctx, HouseholdService_UnscheduledHousehold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HouseholdServiceServer is the server API for HouseholdService service.
+// All implementations must embed UnimplementedHouseholdServiceServer
+// for forward compatibility.
+type HouseholdServiceServer interface {
+	GetHousehold(context.Context, *HouseholdIDIn) (*HouseholdResponse, error)
+	SearchHousehold(context.Context, *Household) (*HouseholdRepeatResponse, error)
+	CreateHousehold(context.Context, *Household) (*HouseholdResponse, error)
+	UpdateHousehold(context.Context, *Household) (*Result, error)
+	DeleteHousehold(context.Context, *HouseholdIDIn) (*Result, error)
+	UnscheduledHousehold(context.Context, *UnscheduledHouseholdIn) (*HouseholdRepeatResponse, error)
+	mustEmbedUnimplementedHouseholdServiceServer()
+}
+
+// UnimplementedHouseholdServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHouseholdServiceServer struct{}
+
+func (UnimplementedHouseholdServiceServer) GetHousehold(context.Context, *HouseholdIDIn) (*HouseholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) SearchHousehold(context.Context, *Household) (*HouseholdRepeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) CreateHousehold(context.Context, *Household) (*HouseholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) UpdateHousehold(context.Context, *Household) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) DeleteHousehold(context.Context, *HouseholdIDIn) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) UnscheduledHousehold(context.Context, *UnscheduledHouseholdIn) (*HouseholdRepeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnscheduledHousehold not implemented")
+}
+func (UnimplementedHouseholdServiceServer) mustEmbedUnimplementedHouseholdServiceServer() {}
+func (UnimplementedHouseholdServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeHouseholdServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HouseholdServiceServer will
+// result in compilation errors.
+type UnsafeHouseholdServiceServer interface {
+	mustEmbedUnimplementedHouseholdServiceServer()
+}
+
+func RegisterHouseholdServiceServer(s grpc.ServiceRegistrar, srv HouseholdServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHouseholdServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HouseholdService_ServiceDesc, srv)
+}
+
+func _HouseholdService_GetHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdIDIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).GetHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_GetHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).GetHousehold(ctx, req.(*HouseholdIDIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_SearchHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Household)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).SearchHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_SearchHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).SearchHousehold(ctx, req.(*Household))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_CreateHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Household)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).CreateHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_CreateHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).CreateHousehold(ctx, req.(*Household))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_UpdateHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Household)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).UpdateHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_UpdateHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).UpdateHousehold(ctx, req.(*Household))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_DeleteHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdIDIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).DeleteHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_DeleteHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).DeleteHousehold(ctx, req.(*HouseholdIDIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdService_UnscheduledHousehold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnscheduledHouseholdIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdServiceServer).UnscheduledHousehold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdService_UnscheduledHousehold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdServiceServer).UnscheduledHousehold(ctx, req.(*UnscheduledHouseholdIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HouseholdService_ServiceDesc is the grpc.ServiceDesc for HouseholdService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HouseholdService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.HouseholdService",
+	HandlerType: (*HouseholdServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHousehold",
+			Handler:    _HouseholdService_GetHousehold_Handler,
+		},
+		{
+			MethodName: "SearchHousehold",
+			Handler:    _HouseholdService_SearchHousehold_Handler,
+		},
+		{
+			MethodName: "CreateHousehold",
+			Handler:    _HouseholdService_CreateHousehold_Handler,
+		},
+		{
+			MethodName: "UpdateHousehold",
+			Handler:    _HouseholdService_UpdateHousehold_Handler,
+		},
+		{
+			MethodName: "DeleteHousehold",
+			Handler:    _HouseholdService_DeleteHousehold_Handler,
+		},
+		{
+			MethodName: "UnscheduledHousehold",
+			Handler:    _HouseholdService_UnscheduledHousehold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/tithe-declare.proto",
+}
+
+const (
+	HouseholdMemberService_GetHouseholdMember_FullMethodName    = "/proto.HouseholdMemberService/GetHouseholdMember"
+	HouseholdMemberService_SearchHouseholdMember_FullMethodName = "/proto.HouseholdMemberService/SearchHouseholdMember"
+	HouseholdMemberService_CreateHouseholdMember_FullMethodName = "/proto.HouseholdMemberService/CreateHouseholdMember"
+	HouseholdMemberService_UpdateHouseholdMember_FullMethodName = "/proto.HouseholdMemberService/UpdateHouseholdMember"
+	HouseholdMemberService_DeleteHouseholdMember_FullMethodName = "/proto.HouseholdMemberService/DeleteHouseholdMember"
+)
+
+// HouseholdMemberServiceClient is the client API for HouseholdMemberService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type HouseholdMemberServiceClient interface {
+	GetHouseholdMember(ctx context.Context, in *HouseholdMemberIDIn, opts ...grpc.CallOption) (*HouseholdMemberResponse, error)
+	SearchHouseholdMember(ctx context.Context, in *HouseholdMember, opts ...grpc.CallOption) (*HouseholdMemberRepeatResponse, error)
+	CreateHouseholdMember(ctx context.Context, in *HouseholdMember, opts ...grpc.CallOption) (*HouseholdMemberResponse, error)
+	UpdateHouseholdMember(ctx context.Context, in *HouseholdMember, opts ...grpc.CallOption) (*Result, error)
+	DeleteHouseholdMember(ctx context.Context, in *HouseholdMemberIDIn, opts ...grpc.CallOption) (*Result, error)
+}
+
+type householdMemberServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHouseholdMemberServiceClient(cc grpc.ClientConnInterface) HouseholdMemberServiceClient {
+	return &householdMemberServiceClient{cc}
+}
+
+func (c *householdMemberServiceClient) GetHouseholdMember(ctx context.Context, in *HouseholdMemberIDIn, opts ...grpc.CallOption) (*HouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, HouseholdMemberService_GetHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdMemberServiceClient) SearchHouseholdMember(ctx context.Context, in *HouseholdMember, opts ...grpc.CallOption) (*HouseholdMemberRepeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdMemberRepeatResponse)
+	err := c.cc.Invoke(ctx, HouseholdMemberService_SearchHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdMemberServiceClient) CreateHouseholdMember(ctx context.Context, in *HouseholdMember, opts ...grpc.CallOption) (*HouseholdMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HouseholdMemberResponse)
+	err := c.cc.Invoke(ctx, HouseholdMemberService_CreateHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdMemberServiceClient) UpdateHouseholdMember(ctx context.Context, in *HouseholdMember, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, HouseholdMemberService_UpdateHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *householdMemberServiceClient) DeleteHouseholdMember(ctx context.Context, in *HouseholdMemberIDIn, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, HouseholdMemberService_DeleteHouseholdMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HouseholdMemberServiceServer is the server API for HouseholdMemberService service.
+// All implementations must embed UnimplementedHouseholdMemberServiceServer
+// for forward compatibility.
+type HouseholdMemberServiceServer interface {
+	GetHouseholdMember(context.Context, *HouseholdMemberIDIn) (*HouseholdMemberResponse, error)
+	SearchHouseholdMember(context.Context, *HouseholdMember) (*HouseholdMemberRepeatResponse, error)
+	CreateHouseholdMember(context.Context, *HouseholdMember) (*HouseholdMemberResponse, error)
+	UpdateHouseholdMember(context.Context, *HouseholdMember) (*Result, error)
+	DeleteHouseholdMember(context.Context, *HouseholdMemberIDIn) (*Result, error)
+	mustEmbedUnimplementedHouseholdMemberServiceServer()
+}
+
+// UnimplementedHouseholdMemberServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHouseholdMemberServiceServer struct{}
+
+func (UnimplementedHouseholdMemberServiceServer) GetHouseholdMember(context.Context, *HouseholdMemberIDIn) (*HouseholdMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHouseholdMember not implemented")
+}
+func (UnimplementedHouseholdMemberServiceServer) SearchHouseholdMember(context.Context, *HouseholdMember) (*HouseholdMemberRepeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchHouseholdMember not implemented")
+}
+func (UnimplementedHouseholdMemberServiceServer) CreateHouseholdMember(context.Context, *HouseholdMember) (*HouseholdMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHouseholdMember not implemented")
+}
+func (UnimplementedHouseholdMemberServiceServer) UpdateHouseholdMember(context.Context, *HouseholdMember) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHouseholdMember not implemented")
+}
+func (UnimplementedHouseholdMemberServiceServer) DeleteHouseholdMember(context.Context, *HouseholdMemberIDIn) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHouseholdMember not implemented")
+}
+func (UnimplementedHouseholdMemberServiceServer) mustEmbedUnimplementedHouseholdMemberServiceServer() {
+}
+func (UnimplementedHouseholdMemberServiceServer) testEmbeddedByValue() {}
+
+// UnsafeHouseholdMemberServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HouseholdMemberServiceServer will
+// result in compilation errors.
+type UnsafeHouseholdMemberServiceServer interface {
+	mustEmbedUnimplementedHouseholdMemberServiceServer()
+}
+
+func RegisterHouseholdMemberServiceServer(s grpc.ServiceRegistrar, srv HouseholdMemberServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHouseholdMemberServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HouseholdMemberService_ServiceDesc, srv)
+}
+
+func _HouseholdMemberService_GetHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdMemberIDIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdMemberServiceServer).GetHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdMemberService_GetHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdMemberServiceServer).GetHouseholdMember(ctx, req.(*HouseholdMemberIDIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdMemberService_SearchHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdMemberServiceServer).SearchHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdMemberService_SearchHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdMemberServiceServer).SearchHouseholdMember(ctx, req.(*HouseholdMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdMemberService_CreateHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdMemberServiceServer).CreateHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdMemberService_CreateHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdMemberServiceServer).CreateHouseholdMember(ctx, req.(*HouseholdMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdMemberService_UpdateHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdMemberServiceServer).UpdateHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdMemberService_UpdateHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdMemberServiceServer).UpdateHouseholdMember(ctx, req.(*HouseholdMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HouseholdMemberService_DeleteHouseholdMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HouseholdMemberIDIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HouseholdMemberServiceServer).DeleteHouseholdMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HouseholdMemberService_DeleteHouseholdMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HouseholdMemberServiceServer).DeleteHouseholdMember(ctx, req.(*HouseholdMemberIDIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HouseholdMemberService_ServiceDesc is the grpc.ServiceDesc for HouseholdMemberService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HouseholdMemberService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.HouseholdMemberService",
+	HandlerType: (*HouseholdMemberServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetHouseholdMember",
+			Handler:    _HouseholdMemberService_GetHouseholdMember_Handler,
+		},
+		{
+			MethodName: "SearchHouseholdMember",
+			Handler:    _HouseholdMemberService_SearchHouseholdMember_Handler,
+		},
+		{
+			MethodName: "CreateHouseholdMember",
+			Handler:    _HouseholdMemberService_CreateHouseholdMember_Handler,
+		},
+		{
+			MethodName: "UpdateHouseholdMember",
+			Handler:    _HouseholdMemberService_UpdateHouseholdMember_Handler,
+		},
+		{
+			MethodName: "DeleteHouseholdMember",
+			Handler:    _HouseholdMemberService_DeleteHouseholdMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/tithe-declare.proto",
+}
+
+const (
+	BlackoutDateService_GetBlackoutDate_FullMethodName    = "/proto.BlackoutDateService/GetBlackoutDate"
+	BlackoutDateService_SearchBlackoutDate_FullMethodName = "/proto.BlackoutDateService/SearchBlackoutDate"
+	BlackoutDateService_CreateBlackoutDate_FullMethodName = "/proto.BlackoutDateService/CreateBlackoutDate"
+	BlackoutDateService_UpdateBlackoutDate_FullMethodName = "/proto.BlackoutDateService/UpdateBlackoutDate"
+	BlackoutDateService_DeleteBlackoutDate_FullMethodName = "/proto.BlackoutDateService/DeleteBlackoutDate"
+)
+
+// BlackoutDateServiceClient is the client API for BlackoutDateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BlackoutDateServiceClient interface {
+	GetBlackoutDate(ctx context.Context, in *BlackoutDateIDIn, opts ...grpc.CallOption) (*BlackoutDateResponse, error)
+	SearchBlackoutDate(ctx context.Context, in *BlackoutDate, opts ...grpc.CallOption) (*BlackoutDateRepeatResponse, error)
+	CreateBlackoutDate(ctx context.Context, in *BlackoutDate, opts ...grpc.CallOption) (*BlackoutDateResponse, error)
+	UpdateBlackoutDate(ctx context.Context, in *BlackoutDate, opts ...grpc.CallOption) (*Result, error)
+	DeleteBlackoutDate(ctx context.Context, in *BlackoutDateIDIn, opts ...grpc.CallOption) (*Result, error)
+}
+
+type blackoutDateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlackoutDateServiceClient(cc grpc.ClientConnInterface) BlackoutDateServiceClient {
+	return &blackoutDateServiceClient{cc}
+}
+
+func (c *blackoutDateServiceClient) GetBlackoutDate(ctx context.Context, in *BlackoutDateIDIn, opts ...grpc.CallOption) (*BlackoutDateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlackoutDateResponse)
+	err := c.cc.Invoke(ctx, BlackoutDateService_GetBlackoutDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blackoutDateServiceClient) SearchBlackoutDate(ctx context.Context, in *BlackoutDate, opts ...grpc.CallOption) (*BlackoutDateRepeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlackoutDateRepeatResponse)
+	err := c.cc.Invoke(ctx, BlackoutDateService_SearchBlackoutDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blackoutDateServiceClient) CreateBlackoutDate(ctx context.Context, in *BlackoutDate, opts ...grpc.CallOption) (*BlackoutDateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlackoutDateResponse)
+	err := c.cc.Invoke(ctx, BlackoutDateService_CreateBlackoutDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blackoutDateServiceClient) UpdateBlackoutDate(ctx context.Context, in *BlackoutDate, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, BlackoutDateService_UpdateBlackoutDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blackoutDateServiceClient) DeleteBlackoutDate(ctx context.Context, in *BlackoutDateIDIn, opts ...grpc.CallOption) (*Result, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Result)
+	err := c.cc.Invoke(ctx, BlackoutDateService_DeleteBlackoutDate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlackoutDateServiceServer is the server API for BlackoutDateService service.
+// All implementations must embed UnimplementedBlackoutDateServiceServer
+// for forward compatibility.
+type BlackoutDateServiceServer interface {
+	GetBlackoutDate(context.Context, *BlackoutDateIDIn) (*BlackoutDateResponse, error)
+	SearchBlackoutDate(context.Context, *BlackoutDate) (*BlackoutDateRepeatResponse, error)
+	CreateBlackoutDate(context.Context, *BlackoutDate) (*BlackoutDateResponse, error)
+	UpdateBlackoutDate(context.Context, *BlackoutDate) (*Result, error)
+	DeleteBlackoutDate(context.Context, *BlackoutDateIDIn) (*Result, error)
+	mustEmbedUnimplementedBlackoutDateServiceServer()
+}
+
+// UnimplementedBlackoutDateServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBlackoutDateServiceServer struct{}
+
+func (UnimplementedBlackoutDateServiceServer) GetBlackoutDate(context.Context, *BlackoutDateIDIn) (*BlackoutDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlackoutDate not implemented")
+}
+func (UnimplementedBlackoutDateServiceServer) SearchBlackoutDate(context.Context, *BlackoutDate) (*BlackoutDateRepeatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBlackoutDate not implemented")
+}
+func (UnimplementedBlackoutDateServiceServer) CreateBlackoutDate(context.Context, *BlackoutDate) (*BlackoutDateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlackoutDate not implemented")
+}
+func (UnimplementedBlackoutDateServiceServer) UpdateBlackoutDate(context.Context, *BlackoutDate) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlackoutDate not implemented")
+}
+func (UnimplementedBlackoutDateServiceServer) DeleteBlackoutDate(context.Context, *BlackoutDateIDIn) (*Result, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlackoutDate not implemented")
+}
+func (UnimplementedBlackoutDateServiceServer) mustEmbedUnimplementedBlackoutDateServiceServer() {}
+func (UnimplementedBlackoutDateServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeBlackoutDateServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BlackoutDateServiceServer will
+// result in compilation errors.
+type UnsafeBlackoutDateServiceServer interface {
+	mustEmbedUnimplementedBlackoutDateServiceServer()
+}
+
+func RegisterBlackoutDateServiceServer(s grpc.ServiceRegistrar, srv BlackoutDateServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBlackoutDateServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BlackoutDateService_ServiceDesc, srv)
+}
+
+func _BlackoutDateService_GetBlackoutDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlackoutDateIDIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlackoutDateServiceServer).GetBlackoutDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlackoutDateService_GetBlackoutDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlackoutDateServiceServer).GetBlackoutDate(ctx, req.(*BlackoutDateIDIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlackoutDateService_SearchBlackoutDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlackoutDate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlackoutDateServiceServer).SearchBlackoutDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlackoutDateService_SearchBlackoutDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlackoutDateServiceServer).SearchBlackoutDate(ctx, req.(*BlackoutDate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlackoutDateService_CreateBlackoutDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlackoutDate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlackoutDateServiceServer).CreateBlackoutDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlackoutDateService_CreateBlackoutDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlackoutDateServiceServer).CreateBlackoutDate(ctx, req.(*BlackoutDate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlackoutDateService_UpdateBlackoutDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlackoutDate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlackoutDateServiceServer).UpdateBlackoutDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlackoutDateService_UpdateBlackoutDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlackoutDateServiceServer).UpdateBlackoutDate(ctx, req.(*BlackoutDate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlackoutDateService_DeleteBlackoutDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlackoutDateIDIn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlackoutDateServiceServer).DeleteBlackoutDate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BlackoutDateService_DeleteBlackoutDate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlackoutDateServiceServer).DeleteBlackoutDate(ctx, req.(*BlackoutDateIDIn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BlackoutDateService_ServiceDesc is the grpc.ServiceDesc for BlackoutDateService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BlackoutDateService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.BlackoutDateService",
+	HandlerType: (*BlackoutDateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlackoutDate",
+			Handler:    _BlackoutDateService_GetBlackoutDate_Handler,
+		},
+		{
+			MethodName: "SearchBlackoutDate",
+			Handler:    _BlackoutDateService_SearchBlackoutDate_Handler,
+		},
+		{
+			MethodName: "CreateBlackoutDate",
+			Handler:    _BlackoutDateService_CreateBlackoutDate_Handler,
+		},
+		{
+			MethodName: "UpdateBlackoutDate",
+			Handler:    _BlackoutDateService_UpdateBlackoutDate_Handler,
+		},
+		{
+			MethodName: "DeleteBlackoutDate",
+			Handler:    _BlackoutDateService_DeleteBlackoutDate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/proto/tithe-declare.proto",
+}
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS schedule_template (
+	id INT PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	start_date VARCHAR(10) NOT NULL,
+	end_date VARCHAR(10) NOT NULL,
+	start_time VARCHAR(5) NOT NULL,
+	end_time VARCHAR(5) NOT NULL,
+	weekdays JSON NOT NULL,
+	excluded_dates JSON
+)
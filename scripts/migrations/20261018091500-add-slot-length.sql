@@ -0,0 +1,3 @@
+ALTER TABLE td_date ADD COLUMN end_value DATE;
+ALTER TABLE schedule_template ADD COLUMN slot_duration INT;
+ALTER TABLE schedule_template ADD COLUMN buffer_time INT;
@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS interviewer (
+	id INT PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	title VARCHAR(100),
+	email VARCHAR(255),
+	active BOOLEAN NOT NULL DEFAULT 1
+)
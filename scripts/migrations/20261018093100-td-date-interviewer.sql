@@ -0,0 +1,18 @@
+-- date_value is no longer unique on its own, each interviewer gets their own slot at the same time
+-- interviewer_id 0 is the unassigned interviewer (all slots created before interviewers existed)
+CREATE TABLE td_date_new (
+	id INT PRIMARY KEY,
+	interviewer_id INT NOT NULL DEFAULT 0,
+	date_value DATE NOT NULL,
+	end_value DATE,
+	hold DATE,
+	confirm DATE,
+	name VARCHAR(255),
+	phone VARCHAR(255),
+	email VARCHAR(255),
+	UNIQUE (interviewer_id, date_value)
+);
+INSERT INTO td_date_new (id, interviewer_id, date_value, end_value, hold, confirm, name, phone, email)
+	SELECT id, 0, date_value, end_value, hold, confirm, name, phone, email FROM td_date;
+DROP TABLE td_date;
+ALTER TABLE td_date_new RENAME TO td_date;
@@ -0,0 +1,2 @@
+ALTER TABLE td_date ADD COLUMN manage_token VARCHAR(100);
+CREATE UNIQUE INDEX IF NOT EXISTS td_date_manage_token ON td_date (manage_token);
@@ -0,0 +1,2 @@
+ALTER TABLE td_date ADD COLUMN hold_token VARCHAR(100);
+CREATE UNIQUE INDEX IF NOT EXISTS td_date_hold_token ON td_date (hold_token);
@@ -0,0 +1 @@
+ALTER TABLE td_date ADD COLUMN expires_at DATE;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS waitlist (
+	id INT PRIMARY KEY,
+	name VARCHAR(255) NOT NULL,
+	email VARCHAR(100) NOT NULL,
+	phone VARCHAR(255),
+	preferred_days JSON,
+	created_at DATE NOT NULL,
+	offer_td_date_id INT,
+	offer_token VARCHAR(100),
+	offer_expires_at DATE,
+	claimed_at DATE
+);
+CREATE UNIQUE INDEX IF NOT EXISTS waitlist_offer_token ON waitlist (offer_token);
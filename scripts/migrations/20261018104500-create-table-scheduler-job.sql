@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS scheduler_job (
+	id INT PRIMARY KEY,
+	name VARCHAR(100) NOT NULL UNIQUE,
+	cron VARCHAR(100) NOT NULL,
+	last_run DATE,
+	next_run DATE,
+	running_since DATE,
+	last_error TEXT,
+	last_duration INT
+);
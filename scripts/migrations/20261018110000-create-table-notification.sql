@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS notification (
+	id INT PRIMARY KEY,
+	recipient VARCHAR(100) NOT NULL,
+	td_date_id INT,
+	kind VARCHAR(50) NOT NULL,
+	dedupe_key VARCHAR(255) NOT NULL UNIQUE,
+	body TEXT,
+	calendar TEXT,
+	status VARCHAR(20) NOT NULL,
+	attempts INT NOT NULL DEFAULT 0,
+	last_error TEXT,
+	next_attempt DATE,
+	created_at DATE NOT NULL,
+	sent_at DATE
+);
+CREATE INDEX IF NOT EXISTS notification_due ON notification (status, next_attempt);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS reminder_rule (
+	id INT PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	channel VARCHAR(20) NOT NULL,
+	offset_minutes INT NOT NULL DEFAULT 0,
+	cron VARCHAR(100),
+	template TEXT,
+	active BOOLEAN NOT NULL DEFAULT 1,
+	last_run DATE
+);
+-- the reminders sent before rules existed: the weekly leadership digest and a reminder to each family
+INSERT INTO reminder_rule (id, name, channel, offset_minutes, cron, active) VALUES (1, 'Weekly digest', 'digest', 10080, '0 23 * * fri', 1);
+INSERT INTO reminder_rule (id, name, channel, offset_minutes, cron, active) VALUES (2, 'Day before', 'individual', 1440, NULL, 1);
@@ -0,0 +1 @@
+ALTER TABLE td_date ADD COLUMN sms_opt_in BOOLEAN;
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS household (
+	id INT PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	email VARCHAR(100),
+	phone VARCHAR(20),
+	address VARCHAR(255),
+	active BOOLEAN NOT NULL DEFAULT 1
+);
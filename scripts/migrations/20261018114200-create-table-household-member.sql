@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS household_member (
+	id INT PRIMARY KEY,
+	household_id INT NOT NULL,
+	name VARCHAR(100) NOT NULL,
+	email VARCHAR(100),
+	phone VARCHAR(20)
+);
+CREATE INDEX IF NOT EXISTS household_member_household_id ON household_member (household_id);
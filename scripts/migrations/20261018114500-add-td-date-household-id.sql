@@ -0,0 +1,2 @@
+ALTER TABLE td_date ADD COLUMN household_id INT;
+CREATE INDEX IF NOT EXISTS td_date_household_id ON td_date (household_id);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS season (
+	id INT PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	open_date VARCHAR(10) NOT NULL,
+	close_date VARCHAR(10) NOT NULL,
+	bookable_from VARCHAR(10),
+	archived BOOLEAN NOT NULL DEFAULT 0,
+	archived_at DATE
+);
@@ -0,0 +1,2 @@
+ALTER TABLE td_date ADD COLUMN season_id INT;
+CREATE INDEX IF NOT EXISTS td_date_season_id ON td_date (season_id);
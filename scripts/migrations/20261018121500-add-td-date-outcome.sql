@@ -0,0 +1,2 @@
+ALTER TABLE td_date ADD COLUMN outcome VARCHAR(20);
+ALTER TABLE td_date ADD COLUMN outcome_at DATE;
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS blackout_date (
+	id INT PRIMARY KEY,
+	name VARCHAR(100) NOT NULL,
+	start_date VARCHAR(10) NOT NULL,
+	end_date VARCHAR(10) NOT NULL
+);
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	ae "github.com/blackflagsoftware/tithe-declare/internal/api_error"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/household"
+	"github.com/blackflagsoftware/tithe-declare/internal/entities/tddate"
+)
+
+// this will load slots or bookings from a csv, i.e.: from the old sign-up sheet
+// see README.md for the columns
+
+func main() {
+	var file string
+	var kind string
+	var dryRun bool
+	flag.StringVar(&file, "f", "", "Path to the csv file")
+	flag.StringVar(&kind, "k", tddate.ImportBookings, "What the csv holds: slots or bookings")
+	flag.BoolVar(&dryRun, "dry-run", false, "Check every row and report, nothing is saved")
+	flag.Parse()
+
+	if file == "" {
+		fmt.Println("Missing csv file, use -f <path>")
+		os.Exit(1)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		fmt.Println("Unable to open csv file:", err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	ctx := context.TODO()
+	dTd := tddate.InitializeTdDateV1()
+	household.InitializeHouseholdV1(dTd) // links the imported bookings to their household
+	req := &tddate.ImportRequest{Kind: kind, DryRun: dryRun}
+	errImport := dTd.Import(ctx, f, req)
+	for _, e := range req.Errors {
+		fmt.Printf("line %d: %s %s\n", e.Line, e.Field, e.Message)
+	}
+	if errImport != nil {
+		if apiError, ok := errImport.(ae.ApiError); ok && len(apiError.Fields) > 0 {
+			fmt.Printf("%d row(s) read, nothing was saved\n", req.Rows)
+		} else {
+			fmt.Println("Import error:", errImport)
+		}
+		os.Exit(1)
+	}
+	if dryRun {
+		if len(req.Errors) > 0 {
+			fmt.Printf("dry run: %d row(s) read, %d error(s)\n", req.Rows, len(req.Errors))
+			os.Exit(1)
+		}
+		fmt.Printf("dry run: %d row(s) read, all of them can be imported\n", req.Rows)
+		os.Exit(0)
+	}
+	fmt.Printf("%d %s imported\n", len(req.Created), kind)
+	os.Exit(0)
+}